@@ -0,0 +1,29 @@
+package container
+
+import "iter"
+
+// The capability interfaces below are optional. Generic algorithms which
+// accept a Stack, Queue or Deque may type-assert for them.
+
+// Sized containers report how many elements they hold.
+type Sized interface {
+	Len() int
+	IsEmpty() bool
+}
+
+// Bounded containers hold at most Cap elements.
+type Bounded interface {
+	Cap() int
+	IsFull() bool
+}
+
+// Clearable containers can remove every element at once.
+type Clearable interface {
+	Clear()
+}
+
+// Iterable containers yield their elements without removing them, in an order
+// documented by each implementation.
+type Iterable[T any] interface {
+	All() iter.Seq[T]
+}
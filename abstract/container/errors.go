@@ -0,0 +1,74 @@
+// Package container provides errors shared by the container data structures.
+//
+// Every container reports the same error types so callers can match them
+// with errors.Is or errors.As regardless of the implementation.
+
+package container
+
+import "fmt"
+
+// EmptyError is returned when reading or removing from an empty container.
+type EmptyError struct {
+	// Name of the container type, e.g. "ArrayList".
+	Container string
+
+	// Operation which failed, e.g. "pop". May be empty.
+	Op string
+}
+
+func (e *EmptyError) Error() string {
+	if e.Op == "" {
+		return fmt.Sprintf("%s is empty.", e.Container)
+	}
+	return fmt.Sprintf("Failed to %s from empty %s.", e.Op, e.Container)
+}
+
+func (e *EmptyError) Is(target error) bool {
+	_, ok := target.(*EmptyError)
+	return ok
+}
+
+// FullError is returned when adding to a container at capacity.
+type FullError struct {
+	Container string
+}
+
+func (e *FullError) Error() string {
+	return fmt.Sprintf("%s is full.", e.Container)
+}
+
+func (e *FullError) Is(target error) bool {
+	_, ok := target.(*FullError)
+	return ok
+}
+
+// IndexError is returned when an index falls outside the container.
+type IndexError struct {
+	Container string
+	Index     int
+	Length    int
+}
+
+func (e *IndexError) Error() string {
+	return fmt.Sprintf("Index %d out of range for %s of length %d.", e.Index, e.Container, e.Length)
+}
+
+func (e *IndexError) Is(target error) bool {
+	_, ok := target.(*IndexError)
+	return ok
+}
+
+// HandleError is returned when an element handle does not belong to the
+// container, for example after it was removed.
+type HandleError struct {
+	Container string
+}
+
+func (e *HandleError) Error() string {
+	return fmt.Sprintf("Handle does not belong to %s.", e.Container)
+}
+
+func (e *HandleError) Is(target error) bool {
+	_, ok := target.(*HandleError)
+	return ok
+}
@@ -0,0 +1,49 @@
+package container
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestErrors(t *testing.T) {
+	t.Run("Messages", func(t *testing.T) {
+		tests := []struct {
+			err      error
+			expected string
+		}{
+			{err: &EmptyError{Container: "ArrayList", Op: "pop"}, expected: "Failed to pop from empty ArrayList."},
+			{err: &EmptyError{Container: "ArrayList"}, expected: "ArrayList is empty."},
+			{err: &FullError{Container: "Ring buffer"}, expected: "Ring buffer is full."},
+			{err: &IndexError{Container: "ArrayList", Index: 5, Length: 3}, expected: "Index 5 out of range for ArrayList of length 3."},
+			{err: &HandleError{Container: "DoublyLinkedList"}, expected: "Handle does not belong to DoublyLinkedList."},
+		}
+
+		for i, test := range tests {
+			if test.err.Error() != test.expected {
+				t.Errorf("Test %d failed. Expected %q - Got %q", i, test.expected, test.err.Error())
+			}
+		}
+	})
+
+	t.Run("IsAs", func(t *testing.T) {
+		err := fmt.Errorf("wrapped: %w", &IndexError{Container: "ArrayList", Index: 7, Length: 2})
+
+		if !errors.Is(err, &IndexError{}) {
+			t.Error("errors.Is failed to match wrapped IndexError")
+		}
+		if errors.Is(err, &EmptyError{}) || errors.Is(err, &FullError{}) {
+			t.Error("errors.Is matched the wrong error type")
+		}
+
+		var idxErr *IndexError
+		if !errors.As(err, &idxErr) || idxErr.Index != 7 || idxErr.Length != 2 {
+			t.Errorf("errors.As failed. Got %v", idxErr)
+		}
+
+		var emptyErr *EmptyError
+		if !errors.As(&EmptyError{Container: "StackList"}, &emptyErr) || emptyErr.Container != "StackList" {
+			t.Errorf("errors.As failed. Got %v", emptyErr)
+		}
+	})
+}
@@ -3,7 +3,7 @@
 // Deque (Double-ended queue) is an abstract data structure that supports the
 // following operations.
 
-package stack
+package deque
 
 type Deque[T any] interface {
 	PushBack(element T) error
@@ -0,0 +1,171 @@
+// Package dequetest provides a conformance suite for deque.Deque
+// implementations.
+//
+// Call RunDequeTests from a test with a factory returning a new, empty deque.
+// Bounded deques should hold exactly capacity elements and return a
+// container.FullError beyond that. Unbounded deques may ignore capacity.
+//
+//	func TestConformance(t *testing.T) {
+//		dequetest.RunDequeTests(t, func(capacity int) deque.Deque[int] {
+//			return NewMyDeque[int](capacity)
+//		})
+//	}
+
+package dequetest
+
+import (
+	"errors"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/jdavasligil/golang-dsa/abstract/container"
+	"github.com/jdavasligil/golang-dsa/abstract/deque"
+	"github.com/jdavasligil/golang-dsa/abstract/internal/conformance"
+)
+
+func RunDequeTests(t *testing.T, newDeque func(capacity int) deque.Deque[int]) {
+	t.Run("Empty", func(t *testing.T) {
+		d := newDeque(4)
+
+		reads := []struct {
+			name string
+			read func() (int, error)
+		}{
+			{name: "PopFront", read: d.PopFront},
+			{name: "PopBack", read: d.PopBack},
+			{name: "Front", read: d.Front},
+			{name: "Back", read: d.Back},
+		}
+
+		for _, r := range reads {
+			if _, err := r.read(); !errors.Is(err, &container.EmptyError{}) {
+				t.Errorf("%s Got: %v  Expected: container.EmptyError.", r.name, err)
+			}
+		}
+	})
+
+	t.Run("BothEnds", func(t *testing.T) {
+		d := newDeque(100)
+
+		// Builds 0 1 ... 49 50 ... 99 from the middle outwards.
+		for i := range 50 {
+			if err := d.PushFront(49 - i); err != nil {
+				t.Fatalf("PushFront failed: %v", err)
+			}
+			if err := d.PushBack(50 + i); err != nil {
+				t.Fatalf("PushBack failed: %v", err)
+			}
+		}
+
+		for i := range 50 {
+			if val, err := d.Front(); err != nil || val != i {
+				t.Fatalf("Front = %d (%v) != expected %d", val, err, i)
+			}
+			if val, err := d.PopFront(); err != nil || val != i {
+				t.Fatalf("PopFront = %d (%v) != expected %d", val, err, i)
+			}
+			if val, err := d.Back(); err != nil || val != 99-i {
+				t.Fatalf("Back = %d (%v) != expected %d", val, err, 99-i)
+			}
+			if val, err := d.PopBack(); err != nil || val != 99-i {
+				t.Fatalf("PopBack = %d (%v) != expected %d", val, err, 99-i)
+			}
+		}
+
+		if _, err := d.PopBack(); !errors.Is(err, &container.EmptyError{}) {
+			t.Errorf("PopBack after drain Got: %v  Expected: container.EmptyError.", err)
+		}
+	})
+
+	t.Run("Capacity", func(t *testing.T) {
+		const capacity = 4
+		d := newDeque(capacity)
+
+		for i := range capacity {
+			if err := d.PushBack(i); err != nil {
+				t.Fatalf("PushBack %d within capacity failed: %v", i, err)
+			}
+		}
+
+		err := d.PushBack(capacity)
+		if err == nil {
+			t.Skip("Deque is unbounded")
+		}
+		if !errors.Is(err, &container.FullError{}) {
+			t.Fatalf("PushBack beyond capacity Got: %v  Expected: container.FullError.", err)
+		}
+		if err := d.PushFront(capacity); !errors.Is(err, &container.FullError{}) {
+			t.Fatalf("PushFront beyond capacity Got: %v  Expected: container.FullError.", err)
+		}
+
+		d.PopBack()
+		if err := d.PushFront(-1); err != nil {
+			t.Fatalf("PushFront after PopBack freed space failed: %v", err)
+		}
+	})
+
+	t.Run("Model", func(t *testing.T) {
+		const capacity = 16
+		rng := rand.New(rand.NewPCG(5, 6))
+		d := newDeque(capacity)
+		var model []int
+
+		for step := range 10000 {
+			var val int
+			var err error
+
+			switch op := rng.IntN(6); op {
+			case 0, 1:
+				val = rng.Int()
+				if op == 0 {
+					err = d.PushFront(val)
+				} else {
+					err = d.PushBack(val)
+				}
+				switch {
+				case err == nil && op == 0:
+					model = append([]int{val}, model...)
+				case err == nil:
+					model = append(model, val)
+				case errors.Is(err, &container.FullError{}) && len(model) >= capacity:
+				default:
+					t.Fatalf("Step %d: push with %d elements failed: %v", step, len(model), err)
+				}
+			case 2:
+				val, err = d.PopFront()
+				checkEnd(t, step, "PopFront", val, err, model, 0)
+				if len(model) > 0 {
+					model = model[1:]
+				}
+			case 3:
+				val, err = d.PopBack()
+				checkEnd(t, step, "PopBack", val, err, model, len(model)-1)
+				if len(model) > 0 {
+					model = model[:len(model)-1]
+				}
+			case 4:
+				val, err = d.Front()
+				checkEnd(t, step, "Front", val, err, model, 0)
+			default:
+				val, err = d.Back()
+				checkEnd(t, step, "Back", val, err, model, len(model)-1)
+			}
+
+			conformance.CheckSized(t, step, d, len(model))
+		}
+	})
+}
+
+func checkEnd(t *testing.T, step int, op string, val int, err error, model []int, idx int) {
+	t.Helper()
+
+	if len(model) == 0 {
+		if !errors.Is(err, &container.EmptyError{}) {
+			t.Fatalf("Step %d: %s on empty Got: %v  Expected: container.EmptyError.", step, op, err)
+		}
+		return
+	}
+	if err != nil || val != model[idx] {
+		t.Fatalf("Step %d: %s = %d (%v) != expected %d", step, op, val, err, model[idx])
+	}
+}
@@ -0,0 +1,80 @@
+package dequetest
+
+import (
+	"testing"
+
+	"github.com/jdavasligil/golang-dsa/abstract/container"
+	"github.com/jdavasligil/golang-dsa/abstract/deque"
+)
+
+// Minimal bounded reference implementation to check the suite itself.
+type sliceDeque struct {
+	data     []int
+	capacity int
+}
+
+func (d *sliceDeque) full() error {
+	if len(d.data) == d.capacity {
+		return &container.FullError{Container: "sliceDeque"}
+	}
+	return nil
+}
+
+func (d *sliceDeque) empty() error {
+	if len(d.data) == 0 {
+		return &container.EmptyError{Container: "sliceDeque"}
+	}
+	return nil
+}
+
+func (d *sliceDeque) PushBack(element int) error {
+	if err := d.full(); err != nil {
+		return err
+	}
+	d.data = append(d.data, element)
+	return nil
+}
+
+func (d *sliceDeque) PushFront(element int) error {
+	if err := d.full(); err != nil {
+		return err
+	}
+	d.data = append([]int{element}, d.data...)
+	return nil
+}
+
+func (d *sliceDeque) PopBack() (int, error) {
+	val, err := d.Back()
+	if err == nil {
+		d.data = d.data[:len(d.data)-1]
+	}
+	return val, err
+}
+
+func (d *sliceDeque) PopFront() (int, error) {
+	val, err := d.Front()
+	if err == nil {
+		d.data = d.data[1:]
+	}
+	return val, err
+}
+
+func (d *sliceDeque) Back() (int, error) {
+	if err := d.empty(); err != nil {
+		return 0, err
+	}
+	return d.data[len(d.data)-1], nil
+}
+
+func (d *sliceDeque) Front() (int, error) {
+	if err := d.empty(); err != nil {
+		return 0, err
+	}
+	return d.data[0], nil
+}
+
+func TestRunDequeTests(t *testing.T) {
+	RunDequeTests(t, func(capacity int) deque.Deque[int] {
+		return &sliceDeque{capacity: capacity}
+	})
+}
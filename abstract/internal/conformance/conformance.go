@@ -0,0 +1,23 @@
+// Package conformance holds helpers shared by the stacktest, queuetest and
+// dequetest suites.
+package conformance
+
+import (
+	"testing"
+
+	"github.com/jdavasligil/golang-dsa/abstract/container"
+)
+
+// CheckSized fails the test at step if c implements container.Sized and
+// disagrees with the model's length.
+func CheckSized(t *testing.T, step int, c any, length int) {
+	t.Helper()
+
+	sized, ok := c.(container.Sized)
+	if !ok {
+		return
+	}
+	if sized.Len() != length || sized.IsEmpty() != (length == 0) {
+		t.Fatalf("Step %d: Len %d IsEmpty %v != expected %d", step, sized.Len(), sized.IsEmpty(), length)
+	}
+}
@@ -0,0 +1,140 @@
+// Package queuetest provides a conformance suite for queue.Queue
+// implementations.
+//
+// Call RunQueueTests from a test with a factory returning a new, empty queue.
+// Bounded queues should hold exactly capacity elements and return a
+// container.FullError beyond that. Unbounded queues may ignore capacity.
+//
+//	func TestConformance(t *testing.T) {
+//		queuetest.RunQueueTests(t, func(capacity int) queue.Queue[int] {
+//			return NewMyQueue[int](capacity)
+//		})
+//	}
+
+package queuetest
+
+import (
+	"errors"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/jdavasligil/golang-dsa/abstract/container"
+	"github.com/jdavasligil/golang-dsa/abstract/internal/conformance"
+	"github.com/jdavasligil/golang-dsa/abstract/queue"
+)
+
+func RunQueueTests(t *testing.T, newQueue func(capacity int) queue.Queue[int]) {
+	t.Run("Empty", func(t *testing.T) {
+		q := newQueue(4)
+
+		if _, err := q.Dequeue(); !errors.Is(err, &container.EmptyError{}) {
+			t.Errorf("Dequeue Got: %v  Expected: container.EmptyError.", err)
+		}
+		if _, err := q.Peek(); !errors.Is(err, &container.EmptyError{}) {
+			t.Errorf("Peek Got: %v  Expected: container.EmptyError.", err)
+		}
+	})
+
+	t.Run("FIFO", func(t *testing.T) {
+		q := newQueue(100)
+
+		for i := range 100 {
+			if err := q.Enqueue(i); err != nil {
+				t.Fatalf("Enqueue %d failed: %v", i, err)
+			}
+		}
+
+		for i := range 100 {
+			front, err := q.Peek()
+			if err != nil || front != i {
+				t.Fatalf("Peek = %d (%v) != expected %d", front, err, i)
+			}
+			val, err := q.Dequeue()
+			if err != nil || val != i {
+				t.Fatalf("Dequeue = %d (%v) != expected %d", val, err, i)
+			}
+		}
+
+		if _, err := q.Dequeue(); !errors.Is(err, &container.EmptyError{}) {
+			t.Errorf("Dequeue after drain Got: %v  Expected: container.EmptyError.", err)
+		}
+	})
+
+	t.Run("Capacity", func(t *testing.T) {
+		const capacity = 4
+		q := newQueue(capacity)
+
+		for i := range capacity {
+			if err := q.Enqueue(i); err != nil {
+				t.Fatalf("Enqueue %d within capacity failed: %v", i, err)
+			}
+		}
+
+		err := q.Enqueue(capacity)
+		if err == nil {
+			t.Skip("Queue is unbounded")
+		}
+		if !errors.Is(err, &container.FullError{}) {
+			t.Fatalf("Enqueue beyond capacity Got: %v  Expected: container.FullError.", err)
+		}
+
+		if val, err := q.Dequeue(); err != nil || val != 0 {
+			t.Fatalf("Dequeue = %d (%v) != expected 0", val, err)
+		}
+		if err := q.Enqueue(capacity); err != nil {
+			t.Fatalf("Enqueue after Dequeue freed space failed: %v", err)
+		}
+		for i := 1; i <= capacity; i++ {
+			if val, err := q.Dequeue(); err != nil || val != i {
+				t.Fatalf("Dequeue = %d (%v) != expected %d", val, err, i)
+			}
+		}
+	})
+
+	t.Run("Model", func(t *testing.T) {
+		const capacity = 16
+		rng := rand.New(rand.NewPCG(1, 2))
+		q := newQueue(capacity)
+		var model []int
+
+		for step := range 10000 {
+			switch op := rng.IntN(6); {
+			case op < 3:
+				val := rng.Int()
+				err := q.Enqueue(val)
+				switch {
+				case err == nil:
+					model = append(model, val)
+				case errors.Is(err, &container.FullError{}) && len(model) >= capacity:
+				default:
+					t.Fatalf("Step %d: Enqueue with %d elements failed: %v", step, len(model), err)
+				}
+			case op < 5:
+				val, err := q.Dequeue()
+				checkFront(t, step, "Dequeue", val, err, model)
+				if len(model) > 0 {
+					model = model[1:]
+				}
+			default:
+				val, err := q.Peek()
+				checkFront(t, step, "Peek", val, err, model)
+			}
+
+			conformance.CheckSized(t, step, q, len(model))
+		}
+	})
+}
+
+func checkFront(t *testing.T, step int, op string, val int, err error, model []int) {
+	t.Helper()
+
+	if len(model) == 0 {
+		if !errors.Is(err, &container.EmptyError{}) {
+			t.Fatalf("Step %d: %s on empty Got: %v  Expected: container.EmptyError.", step, op, err)
+		}
+		return
+	}
+	if err != nil || val != model[0] {
+		t.Fatalf("Step %d: %s = %d (%v) != expected %d", step, op, val, err, model[0])
+	}
+}
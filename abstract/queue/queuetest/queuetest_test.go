@@ -0,0 +1,43 @@
+package queuetest
+
+import (
+	"testing"
+
+	"github.com/jdavasligil/golang-dsa/abstract/container"
+	"github.com/jdavasligil/golang-dsa/abstract/queue"
+)
+
+// Minimal bounded reference implementation to check the suite itself.
+type sliceQueue struct {
+	data     []int
+	capacity int
+}
+
+func (q *sliceQueue) Enqueue(element int) error {
+	if len(q.data) == q.capacity {
+		return &container.FullError{Container: "sliceQueue"}
+	}
+	q.data = append(q.data, element)
+	return nil
+}
+
+func (q *sliceQueue) Dequeue() (int, error) {
+	val, err := q.Peek()
+	if err == nil {
+		q.data = q.data[1:]
+	}
+	return val, err
+}
+
+func (q *sliceQueue) Peek() (int, error) {
+	if len(q.data) == 0 {
+		return 0, &container.EmptyError{Container: "sliceQueue"}
+	}
+	return q.data[0], nil
+}
+
+func TestRunQueueTests(t *testing.T) {
+	RunQueueTests(t, func(capacity int) queue.Queue[int] {
+		return &sliceQueue{capacity: capacity}
+	})
+}
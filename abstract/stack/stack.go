@@ -6,7 +6,14 @@
 package stack
 
 type Stack[T any] interface {
+	// Push adds an element to the top. Bounded stacks return a
+	// container.FullError when at capacity.
 	Push(element T) error
+
+	// Pop removes and returns the top element, or a container.EmptyError.
 	Pop() (T, error)
+
+	// Top returns the top element without removing it, or a
+	// container.EmptyError.
 	Top() (T, error)
 }
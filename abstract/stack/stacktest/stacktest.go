@@ -0,0 +1,135 @@
+// Package stacktest provides a conformance suite for stack.Stack
+// implementations.
+//
+// Call RunStackTests from a test with a factory returning a new, empty stack.
+// Bounded stacks should hold exactly capacity elements and return a
+// container.FullError beyond that. Unbounded stacks may ignore capacity.
+//
+//	func TestConformance(t *testing.T) {
+//		stacktest.RunStackTests(t, func(capacity int) stack.Stack[int] {
+//			return NewMyStack[int]()
+//		})
+//	}
+
+package stacktest
+
+import (
+	"errors"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/jdavasligil/golang-dsa/abstract/container"
+	"github.com/jdavasligil/golang-dsa/abstract/internal/conformance"
+	"github.com/jdavasligil/golang-dsa/abstract/stack"
+)
+
+func RunStackTests(t *testing.T, newStack func(capacity int) stack.Stack[int]) {
+	t.Run("Empty", func(t *testing.T) {
+		s := newStack(4)
+
+		if _, err := s.Pop(); !errors.Is(err, &container.EmptyError{}) {
+			t.Errorf("Pop Got: %v  Expected: container.EmptyError.", err)
+		}
+		if _, err := s.Top(); !errors.Is(err, &container.EmptyError{}) {
+			t.Errorf("Top Got: %v  Expected: container.EmptyError.", err)
+		}
+	})
+
+	t.Run("LIFO", func(t *testing.T) {
+		s := newStack(100)
+
+		for i := range 100 {
+			if err := s.Push(i); err != nil {
+				t.Fatalf("Push %d failed: %v", i, err)
+			}
+		}
+
+		for i := 99; i >= 0; i-- {
+			top, err := s.Top()
+			if err != nil || top != i {
+				t.Fatalf("Top = %d (%v) != expected %d", top, err, i)
+			}
+			val, err := s.Pop()
+			if err != nil || val != i {
+				t.Fatalf("Pop = %d (%v) != expected %d", val, err, i)
+			}
+		}
+
+		if _, err := s.Pop(); !errors.Is(err, &container.EmptyError{}) {
+			t.Errorf("Pop after drain Got: %v  Expected: container.EmptyError.", err)
+		}
+	})
+
+	t.Run("Capacity", func(t *testing.T) {
+		const capacity = 4
+		s := newStack(capacity)
+
+		for i := range capacity {
+			if err := s.Push(i); err != nil {
+				t.Fatalf("Push %d within capacity failed: %v", i, err)
+			}
+		}
+
+		err := s.Push(capacity)
+		if err == nil {
+			t.Skip("Stack is unbounded")
+		}
+		if !errors.Is(err, &container.FullError{}) {
+			t.Fatalf("Push beyond capacity Got: %v  Expected: container.FullError.", err)
+		}
+
+		if val, err := s.Pop(); err != nil || val != capacity-1 {
+			t.Fatalf("Pop = %d (%v) != expected %d", val, err, capacity-1)
+		}
+		if err := s.Push(capacity); err != nil {
+			t.Fatalf("Push after Pop freed space failed: %v", err)
+		}
+	})
+
+	t.Run("Model", func(t *testing.T) {
+		const capacity = 16
+		rng := rand.New(rand.NewPCG(3, 4))
+		s := newStack(capacity)
+		var model []int
+
+		for step := range 10000 {
+			switch op := rng.IntN(6); {
+			case op < 3:
+				val := rng.Int()
+				err := s.Push(val)
+				switch {
+				case err == nil:
+					model = append(model, val)
+				case errors.Is(err, &container.FullError{}) && len(model) >= capacity:
+				default:
+					t.Fatalf("Step %d: Push with %d elements failed: %v", step, len(model), err)
+				}
+			case op < 5:
+				val, err := s.Pop()
+				checkTop(t, step, "Pop", val, err, model)
+				if len(model) > 0 {
+					model = model[:len(model)-1]
+				}
+			default:
+				val, err := s.Top()
+				checkTop(t, step, "Top", val, err, model)
+			}
+
+			conformance.CheckSized(t, step, s, len(model))
+		}
+	})
+}
+
+func checkTop(t *testing.T, step int, op string, val int, err error, model []int) {
+	t.Helper()
+
+	if len(model) == 0 {
+		if !errors.Is(err, &container.EmptyError{}) {
+			t.Fatalf("Step %d: %s on empty Got: %v  Expected: container.EmptyError.", step, op, err)
+		}
+		return
+	}
+	if top := model[len(model)-1]; err != nil || val != top {
+		t.Fatalf("Step %d: %s = %d (%v) != expected %d", step, op, val, err, top)
+	}
+}
@@ -0,0 +1,37 @@
+package stacktest
+
+import (
+	"testing"
+
+	"github.com/jdavasligil/golang-dsa/abstract/container"
+	"github.com/jdavasligil/golang-dsa/abstract/stack"
+)
+
+// Minimal reference implementation to check the suite itself.
+type sliceStack struct {
+	data []int
+}
+
+func (s *sliceStack) Push(element int) error {
+	s.data = append(s.data, element)
+	return nil
+}
+
+func (s *sliceStack) Pop() (int, error) {
+	val, err := s.Top()
+	if err == nil {
+		s.data = s.data[:len(s.data)-1]
+	}
+	return val, err
+}
+
+func (s *sliceStack) Top() (int, error) {
+	if len(s.data) == 0 {
+		return 0, &container.EmptyError{Container: "sliceStack"}
+	}
+	return s.data[len(s.data)-1], nil
+}
+
+func TestRunStackTests(t *testing.T) {
+	RunStackTests(t, func(capacity int) stack.Stack[int] { return &sliceStack{} })
+}
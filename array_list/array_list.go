@@ -1,12 +1,65 @@
 // ArrayList
-// FILO data structure using a dynamic array
+// Random access list using a dynamic array. Push, Pop and Top operate on the
+// end of the list so it may also be used as a FILO stack.
 
 package arraylist
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+	"iter"
+	"math"
+	"slices"
+
+	"github.com/jdavasligil/golang-dsa/abstract/container"
+	"github.com/jdavasligil/golang-dsa/abstract/stack"
+)
+
+var (
+	_ stack.Stack[int]        = (*ArrayList[int])(nil)
+	_ container.Sized         = (*ArrayList[int])(nil)
+	_ container.Clearable     = (*ArrayList[int])(nil)
+	_ container.Iterable[int] = (*ArrayList[int])(nil)
+)
+
+// Deprecated: Use container.IndexError.
+type ArrayListIndexError = container.IndexError
+
+var (
+	errPopEmpty = &container.EmptyError{Container: "ArrayList", Op: "pop"}
+	errEmpty    = &container.EmptyError{Container: "ArrayList"}
+)
+
+type ArrayListConstraintError struct {
+	Constraint string
+}
+
+func (e *ArrayListConstraintError) Error() string {
+	return fmt.Sprintf("Constraint violated: %s", e.Constraint)
+}
+
+func (e *ArrayListConstraintError) Is(target error) bool {
+	_, ok := target.(*ArrayListConstraintError)
+	return ok
+}
 
 type ArrayList[T any] struct {
 	Data []T
+
+	// Capacity multiplier when full. Zero defers to append.
+	growthFactor float64
+
+	// Halve capacity when Pop leaves the list a quarter full.
+	shrinkOnPop bool
+
+	// Capacity is never shrunk below this.
+	minCapacity int
+}
+
+type ArrayListOptions struct {
+	Capacity     int
+	GrowthFactor float64
+	ShrinkOnPop  bool
 }
 
 func NewArrayList[T any]() *ArrayList[T] {
@@ -15,30 +68,91 @@ func NewArrayList[T any]() *ArrayList[T] {
 	}
 }
 
-func (s *ArrayList[T]) Push(data T) {
+func NewArrayListWithCapacity[T any](capacity int) *ArrayList[T] {
+	capacity = max(0, capacity)
+	return &ArrayList[T]{
+		Data:        make([]T, 0, capacity),
+		minCapacity: capacity,
+	}
+}
+
+// NewArrayListWithOptions validates opts. Nil opts is the same as
+// NewArrayList.
+func NewArrayListWithOptions[T any](opts *ArrayListOptions) (*ArrayList[T], error) {
+	if opts == nil {
+		return NewArrayList[T](), nil
+	}
+
+	var errs error
+
+	if opts.Capacity < 0 {
+		errs = errors.Join(errs, &ArrayListConstraintError{
+			fmt.Sprintf("Capacity %d >= 0", opts.Capacity),
+		})
+	}
+
+	if opts.GrowthFactor != 0 && opts.GrowthFactor <= 1 {
+		errs = errors.Join(errs, &ArrayListConstraintError{
+			fmt.Sprintf("Growth Factor %.2f > 1 (or 0 for default)", opts.GrowthFactor),
+		})
+	}
+
+	if errs != nil {
+		return nil, errs
+	}
+
+	return &ArrayList[T]{
+		Data:         make([]T, 0, opts.Capacity),
+		growthFactor: opts.GrowthFactor,
+		shrinkOnPop:  opts.ShrinkOnPop,
+		minCapacity:  opts.Capacity,
+	}, nil
+}
+
+// Move the elements into a new backing array of the given capacity.
+func (s *ArrayList[T]) resize(capacity int) {
+	data := make([]T, len(s.Data), capacity)
+	copy(data, s.Data)
+	s.Data = data
+}
+
+// Make room for one more element, applying the growth factor if set.
+func (s *ArrayList[T]) grow() {
+	if s.growthFactor > 0 && len(s.Data) == cap(s.Data) {
+		s.resize(max(cap(s.Data)+1, int(math.Ceil(float64(cap(s.Data))*s.growthFactor))))
+	}
+}
+
+// Push never fails. The error is for conformance with stack.Stack.
+func (s *ArrayList[T]) Push(data T) error {
+	s.grow()
 	s.Data = append(s.Data, data)
+
+	return nil
 }
 
 func (s *ArrayList[T]) Pop() (T, error) {
 	var result T
-	var err error = fmt.Errorf("Failed to pop from empty ArrayList.")
 
 	if len(s.Data) == 0 {
-		return result, err
+		return result, errPopEmpty
 	}
 
 	result = s.Data[len(s.Data)-1]
 	s.Data = s.Data[0 : len(s.Data)-1]
 
+	if s.shrinkOnPop && cap(s.Data) > s.minCapacity && len(s.Data) <= cap(s.Data)/4 {
+		s.resize(max(s.minCapacity, cap(s.Data)/2))
+	}
+
 	return result, nil
 }
 
 func (s *ArrayList[T]) Top() (T, error) {
 	var result T
-	var err error = fmt.Errorf("ArrayList is empty.")
 
 	if len(s.Data) == 0 {
-		return result, err
+		return result, errEmpty
 	}
 
 	result = s.Data[len(s.Data)-1]
@@ -49,3 +163,138 @@ func (s *ArrayList[T]) Top() (T, error) {
 func (s *ArrayList[T]) Len() int {
 	return len(s.Data)
 }
+
+func (s *ArrayList[T]) IsEmpty() bool {
+	return len(s.Data) == 0
+}
+
+// All iterates in index order, from the bottom of the stack to the top.
+func (s *ArrayList[T]) All() iter.Seq[T] {
+	return slices.Values(s.Data)
+}
+
+func (s *ArrayList[T]) Cap() int {
+	return cap(s.Data)
+}
+
+// Reserve ensures at least n more elements can be pushed without
+// reallocating.
+func (s *ArrayList[T]) Reserve(n int) {
+	if need := len(s.Data) + n; need > cap(s.Data) {
+		s.resize(need)
+	}
+}
+
+// ShrinkToFit reallocates so that capacity equals length.
+func (s *ArrayList[T]) ShrinkToFit() {
+	if cap(s.Data) > len(s.Data) {
+		s.resize(len(s.Data))
+	}
+}
+
+func (s *ArrayList[T]) checkIndex(idx int) error {
+	if idx < 0 || idx >= len(s.Data) {
+		return &container.IndexError{Container: "ArrayList", Index: idx, Length: len(s.Data)}
+	}
+	return nil
+}
+
+func (s *ArrayList[T]) checkRange(start, end int) error {
+	if start < 0 || start > len(s.Data) {
+		return &container.IndexError{Container: "ArrayList", Index: start, Length: len(s.Data)}
+	}
+	if end < start || end > len(s.Data) {
+		return &container.IndexError{Container: "ArrayList", Index: end, Length: len(s.Data)}
+	}
+	return nil
+}
+
+func (s *ArrayList[T]) Get(idx int) (T, error) {
+	var result T
+
+	if err := s.checkIndex(idx); err != nil {
+		return result, err
+	}
+
+	return s.Data[idx], nil
+}
+
+func (s *ArrayList[T]) Set(idx int, data T) error {
+	if err := s.checkIndex(idx); err != nil {
+		return err
+	}
+
+	s.Data[idx] = data
+
+	return nil
+}
+
+// Insert places data at idx, shifting later elements up. idx may equal Len to
+// append.
+func (s *ArrayList[T]) Insert(idx int, data T) error {
+	if idx < 0 || idx > len(s.Data) {
+		return &container.IndexError{Container: "ArrayList", Index: idx, Length: len(s.Data)}
+	}
+
+	s.grow()
+	s.Data = slices.Insert(s.Data, idx, data)
+
+	return nil
+}
+
+func (s *ArrayList[T]) RemoveAt(idx int) (T, error) {
+	var result T
+
+	if err := s.checkIndex(idx); err != nil {
+		return result, err
+	}
+
+	result = s.Data[idx]
+	s.Data = slices.Delete(s.Data, idx, idx+1)
+
+	return result, nil
+}
+
+// RemoveRange removes the elements in [start, end).
+func (s *ArrayList[T]) RemoveRange(start, end int) error {
+	if err := s.checkRange(start, end); err != nil {
+		return err
+	}
+
+	s.Data = slices.Delete(s.Data, start, end)
+
+	return nil
+}
+
+// IndexFunc returns the index of the first element satisfying f, or -1.
+func (s *ArrayList[T]) IndexFunc(f func(T) bool) int {
+	return slices.IndexFunc(s.Data, f)
+}
+
+// Slice returns a copy of the elements in [start, end).
+func (s *ArrayList[T]) Slice(start, end int) ([]T, error) {
+	if err := s.checkRange(start, end); err != nil {
+		return nil, err
+	}
+
+	return slices.Clone(s.Data[start:end]), nil
+}
+
+func (s *ArrayList[T]) Reverse() {
+	slices.Reverse(s.Data)
+}
+
+// Clear removes every element, keeping the allocated capacity.
+func (s *ArrayList[T]) Clear() {
+	clear(s.Data)
+	s.Data = s.Data[:0]
+}
+
+// IndexOf returns the index of the first element equal to data, or -1.
+func IndexOf[T comparable](s *ArrayList[T], data T) int {
+	return slices.Index(s.Data, data)
+}
+
+func Contains[T comparable](s *ArrayList[T], data T) bool {
+	return IndexOf(s, data) >= 0
+}
@@ -1,6 +1,15 @@
 package arraylist
 
-import "testing"
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/jdavasligil/golang-dsa/abstract/container"
+	"github.com/jdavasligil/golang-dsa/abstract/stack"
+	"github.com/jdavasligil/golang-dsa/abstract/stack/stacktest"
+)
 
 func TestNewArrayList(t *testing.T) {
 	stack := NewArrayList[rune]()
@@ -116,3 +125,311 @@ func TestArrayListLen(t *testing.T) {
 		t.Errorf("Length %d != expected %d", got, want)
 	}
 }
+
+func TestArrayListGetSet(t *testing.T) {
+	list := NewArrayList[rune]()
+
+	for _, r := range "ABCD" {
+		list.Push(r)
+	}
+
+	val, err := list.Get(2)
+	if err != nil || val != 'C' {
+		t.Errorf("Get(2) = %c (%v) != expected C", val, err)
+	}
+
+	if err := list.Set(2, 'Z'); err != nil {
+		t.Error("Set failed on valid index")
+	}
+	if list.Data[2] != 'Z' {
+		t.Errorf("Data[2] = %c != Z", list.Data[2])
+	}
+
+	for _, idx := range []int{-1, 4} {
+		if _, err := list.Get(idx); !errors.Is(err, &ArrayListIndexError{}) {
+			t.Errorf("Get(%d) Got: %v  Expected: ArrayListIndexError.", idx, err)
+		}
+		if err := list.Set(idx, 'X'); !errors.Is(err, &ArrayListIndexError{}) {
+			t.Errorf("Set(%d) Got: %v  Expected: ArrayListIndexError.", idx, err)
+		}
+	}
+}
+
+func TestArrayListInsertRemove(t *testing.T) {
+	list := NewArrayList[rune]()
+
+	tests := []struct {
+		idx      int
+		val      rune
+		expected string
+	}{
+		{idx: 0, val: 'B', expected: "B"},
+		{idx: 0, val: 'A', expected: "AB"},
+		{idx: 2, val: 'D', expected: "ABD"},
+		{idx: 2, val: 'C', expected: "ABCD"},
+	}
+
+	for i, test := range tests {
+		if err := list.Insert(test.idx, test.val); err != nil {
+			t.Fatalf("Test %d: Insert failed: %v", i, err)
+		}
+		if string(list.Data) != test.expected {
+			t.Errorf("Test %d failed. Expected %s - Got %s", i, test.expected, string(list.Data))
+		}
+	}
+
+	if err := list.Insert(5, 'X'); !errors.Is(err, &ArrayListIndexError{}) {
+		t.Errorf("Got: %v  Expected: ArrayListIndexError.", err)
+	}
+
+	val, err := list.RemoveAt(1)
+	if err != nil || val != 'B' || string(list.Data) != "ACD" {
+		t.Errorf("RemoveAt(1) = %c (%v), list %s", val, err, string(list.Data))
+	}
+	if _, err := list.RemoveAt(3); !errors.Is(err, &ArrayListIndexError{}) {
+		t.Errorf("Got: %v  Expected: ArrayListIndexError.", err)
+	}
+
+	if err := list.RemoveRange(0, 2); err != nil || string(list.Data) != "D" {
+		t.Errorf("RemoveRange(0, 2) left %s (%v)", string(list.Data), err)
+	}
+	if err := list.RemoveRange(1, 0); !errors.Is(err, &ArrayListIndexError{}) {
+		t.Errorf("Got: %v  Expected: ArrayListIndexError.", err)
+	}
+}
+
+func TestArrayListSearch(t *testing.T) {
+	list := NewArrayList[rune]()
+
+	for _, r := range "ABCB" {
+		list.Push(r)
+	}
+
+	if idx := IndexOf(list, 'B'); idx != 1 {
+		t.Errorf("IndexOf B = %d != expected 1", idx)
+	}
+	if idx := IndexOf(list, 'Z'); idx != -1 {
+		t.Errorf("IndexOf Z = %d != expected -1", idx)
+	}
+	if !Contains(list, 'C') || Contains(list, 'Z') {
+		t.Error("Contains returned wrong result")
+	}
+	if idx := list.IndexFunc(func(r rune) bool { return r > 'B' }); idx != 2 {
+		t.Errorf("IndexFunc = %d != expected 2", idx)
+	}
+}
+
+func TestArrayListSliceReverseClear(t *testing.T) {
+	list := NewArrayList[rune]()
+
+	for _, r := range "ABCD" {
+		list.Push(r)
+	}
+
+	s, err := list.Slice(1, 3)
+	if err != nil || string(s) != "BC" {
+		t.Errorf("Slice(1, 3) = %s (%v) != expected BC", string(s), err)
+	}
+	s[0] = 'Z'
+	if list.Data[1] != 'B' {
+		t.Error("Slice must return a copy")
+	}
+	if _, err := list.Slice(2, 5); !errors.Is(err, &ArrayListIndexError{}) {
+		t.Errorf("Got: %v  Expected: ArrayListIndexError.", err)
+	}
+
+	list.Reverse()
+	if string(list.Data) != "DCBA" {
+		t.Errorf("Reverse = %s != expected DCBA", string(list.Data))
+	}
+
+	list.Clear()
+	if list.Len() != 0 {
+		t.Errorf("Length %d != expected 0 after Clear", list.Len())
+	}
+	if _, err := list.Pop(); err == nil {
+		t.Error("Popping a cleared list should return an error")
+	}
+}
+
+func TestArrayListCapacity(t *testing.T) {
+	list := NewArrayListWithCapacity[int](8)
+	if list.Cap() != 8 || list.Len() != 0 {
+		t.Errorf("Cap %d Len %d != expected 8 0", list.Cap(), list.Len())
+	}
+
+	list.Reserve(20)
+	if list.Cap() < 20 {
+		t.Errorf("Cap %d < 20 after Reserve", list.Cap())
+	}
+
+	list.Push(1)
+	list.Push(2)
+	list.ShrinkToFit()
+	if list.Cap() != 2 || list.Data[0] != 1 || list.Data[1] != 2 {
+		t.Errorf("ShrinkToFit left cap %d data %v", list.Cap(), list.Data)
+	}
+}
+
+func TestArrayListOptions(t *testing.T) {
+	t.Run("Invalid", func(t *testing.T) {
+		_, err := NewArrayListWithOptions[int](&ArrayListOptions{Capacity: -1, GrowthFactor: 0.5})
+		if !errors.Is(err, &ArrayListConstraintError{}) {
+			t.Errorf("Got: %v  Expected: ArrayListConstraintError.", err)
+		}
+	})
+
+	t.Run("Nil", func(t *testing.T) {
+		list, err := NewArrayListWithOptions[int](nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if list.Cap() != NewArrayList[int]().Cap() {
+			t.Errorf("Nil options cap %d != default %d", list.Cap(), NewArrayList[int]().Cap())
+		}
+		list.Push(1)
+		if val, _ := list.Pop(); val != 1 {
+			t.Errorf("Pop %d != expected 1", val)
+		}
+	})
+
+	t.Run("GrowthFactor", func(t *testing.T) {
+		list, err := NewArrayListWithOptions[int](&ArrayListOptions{Capacity: 4, GrowthFactor: 1.5})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		expected := []int{4, 4, 4, 4, 6, 6, 9, 9, 9, 14}
+		for i, want := range expected {
+			list.Push(i)
+			if list.Cap() != want {
+				t.Errorf("Push %d: Cap %d != expected %d", i, list.Cap(), want)
+			}
+		}
+
+		list, _ = NewArrayListWithOptions[int](&ArrayListOptions{Capacity: 4, GrowthFactor: 1.5})
+		for i, want := range expected {
+			list.Insert(0, i)
+			if list.Cap() != want {
+				t.Errorf("Insert %d: Cap %d != expected %d", i, list.Cap(), want)
+			}
+		}
+	})
+
+	t.Run("ShrinkOnPop", func(t *testing.T) {
+		list, err := NewArrayListWithOptions[int](&ArrayListOptions{Capacity: 4, GrowthFactor: 2, ShrinkOnPop: true})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for i := range 64 {
+			list.Push(i)
+		}
+		if list.Cap() != 64 {
+			t.Errorf("Cap %d != expected 64", list.Cap())
+		}
+
+		for i := 63; i >= 0; i-- {
+			val, err := list.Pop()
+			if err != nil || val != i {
+				t.Fatalf("Pop = %d (%v) != expected %d", val, err, i)
+			}
+			if list.Len() > 0 && list.Cap() > 4*list.Len() && list.Cap() > 4 {
+				t.Fatalf("Cap %d not shrunk for Len %d", list.Cap(), list.Len())
+			}
+		}
+		if list.Cap() != 4 {
+			t.Errorf("Cap %d shrunk below minimum 4", list.Cap())
+		}
+	})
+}
+
+func BenchmarkArrayListPush(b *testing.B) {
+	policies := []struct {
+		name string
+		opts ArrayListOptions
+	}{
+		{name: "Default1024", opts: ArrayListOptions{Capacity: 1024}},
+		{name: "Empty", opts: ArrayListOptions{}},
+		{name: "Growth1.5", opts: ArrayListOptions{Capacity: 4, GrowthFactor: 1.5}},
+		{name: "Growth2", opts: ArrayListOptions{Capacity: 4, GrowthFactor: 2}},
+		{name: "Growth2Shrink", opts: ArrayListOptions{Capacity: 4, GrowthFactor: 2, ShrinkOnPop: true}},
+	}
+
+	for _, size := range []int{16, 4096} {
+		for _, policy := range policies {
+			b.Run(fmt.Sprintf("%s/%d", policy.name, size), func(b *testing.B) {
+				b.ReportAllocs()
+				var capacity int
+
+				for b.Loop() {
+					list, _ := NewArrayListWithOptions[int](&policy.opts)
+					for i := range size {
+						list.Push(i)
+					}
+					capacity = list.Cap()
+					for range size {
+						list.Pop()
+					}
+				}
+
+				b.ReportMetric(float64(capacity*8), "peak-bytes")
+			})
+		}
+	}
+}
+
+func TestArrayListErrors(t *testing.T) {
+	list := NewArrayList[rune]()
+
+	tests := []struct {
+		err      error
+		expected string
+	}{
+		{err: second(list.Pop()), expected: "Failed to pop from empty ArrayList."},
+		{err: second(list.Top()), expected: "ArrayList is empty."},
+	}
+
+	for i, test := range tests {
+		var emptyErr *container.EmptyError
+		if !errors.As(test.err, &emptyErr) || emptyErr.Container != "ArrayList" {
+			t.Errorf("Test %d: Got: %v  Expected: container.EmptyError.", i, test.err)
+		}
+		if test.err.Error() != test.expected {
+			t.Errorf("Test %d: Message %q != expected %q", i, test.err.Error(), test.expected)
+		}
+	}
+
+	_, err := list.Get(3)
+	var idxErr *container.IndexError
+	if !errors.As(err, &idxErr) || idxErr.Index != 3 || idxErr.Length != 0 {
+		t.Errorf("Got: %v  Expected: container.IndexError.", err)
+	}
+}
+
+func second[T any](_ T, err error) error {
+	return err
+}
+
+func TestArrayListStackConformance(t *testing.T) {
+	stacktest.RunStackTests(t, func(capacity int) stack.Stack[int] { return NewArrayListWithCapacity[int](capacity) })
+}
+
+func TestArrayListCapabilities(t *testing.T) {
+	list := NewArrayList[rune]()
+
+	if !list.IsEmpty() {
+		t.Error("New ArrayList should be empty")
+	}
+
+	for _, r := range "ABC" {
+		list.Push(r)
+	}
+
+	if list.IsEmpty() {
+		t.Error("ArrayList with elements should not be empty")
+	}
+	if got := string(slices.Collect(list.All())); got != "ABC" {
+		t.Errorf("All = %s != expected ABC", got)
+	}
+}
@@ -0,0 +1,175 @@
+package bitset
+
+import (
+	"math/bits"
+	"sync/atomic"
+)
+
+// AtomicBitSet64 is a BitSet64 which may be shared between goroutines
+// without a mutex. The zero value is an empty set ready to use.
+type AtomicBitSet64 struct {
+	v atomic.Uint64
+}
+
+func (b *AtomicBitSet64) Set(idx uint8) {
+	b.v.Or(1 << idx)
+}
+
+func (b *AtomicBitSet64) Unset(idx uint8) {
+	b.v.And(^(uint64(1) << idx))
+}
+
+func (b *AtomicBitSet64) Get(idx uint8) bool {
+	return (b.v.Load() & (1 << idx)) != 0
+}
+
+// TestAndSet sets the bit and reports whether it was already set.
+func (b *AtomicBitSet64) TestAndSet(idx uint8) bool {
+	mask := uint64(1) << idx
+	return (b.v.Or(mask) & mask) != 0
+}
+
+// TestAndClear unsets the bit and reports whether it was previously set.
+func (b *AtomicBitSet64) TestAndClear(idx uint8) bool {
+	mask := uint64(1) << idx
+	return (b.v.And(^mask) & mask) != 0
+}
+
+// CompareAndSwap replaces the whole set with new only if it currently equals old.
+func (b *AtomicBitSet64) CompareAndSwap(old, new BitSet64) bool {
+	return b.v.CompareAndSwap(uint64(old), uint64(new))
+}
+
+func (b *AtomicBitSet64) Load() BitSet64 {
+	return BitSet64(b.v.Load())
+}
+
+func (b *AtomicBitSet64) Store(val BitSet64) {
+	b.v.Store(uint64(val))
+}
+
+// ClaimNext atomically sets the lowest unset bit and returns its index.
+// Returns false if every bit is already set.
+func (b *AtomicBitSet64) ClaimNext() (uint8, bool) {
+	for {
+		old := b.v.Load()
+		if old == ^uint64(0) {
+			return 0, false
+		}
+
+		idx := bits.TrailingZeros64(^old)
+		if b.v.CompareAndSwap(old, old|(1<<idx)) {
+			return uint8(idx), true
+		}
+	}
+}
+
+// AtomicBitSet is a fixed size multi-word bitset which may be shared between
+// goroutines without a mutex. Indices outside [0, Len) are ignored.
+type AtomicBitSet struct {
+	words  []atomic.Uint64
+	length int
+}
+
+func NewAtomicBitSet(length int) *AtomicBitSet {
+	return &AtomicBitSet{
+		words:  make([]atomic.Uint64, (length+63)/64),
+		length: length,
+	}
+}
+
+func (b *AtomicBitSet) Len() int {
+	return b.length
+}
+
+func (b *AtomicBitSet) inRange(idx int) bool {
+	return idx >= 0 && idx < b.length
+}
+
+func (b *AtomicBitSet) Set(idx int) {
+	if b.inRange(idx) {
+		b.words[idx/64].Or(1 << (idx % 64))
+	}
+}
+
+func (b *AtomicBitSet) Unset(idx int) {
+	if b.inRange(idx) {
+		b.words[idx/64].And(^(uint64(1) << (idx % 64)))
+	}
+}
+
+func (b *AtomicBitSet) Get(idx int) bool {
+	if !b.inRange(idx) {
+		return false
+	}
+	return (b.words[idx/64].Load() & (1 << (idx % 64))) != 0
+}
+
+// TestAndSet sets the bit and reports whether it was already set.
+func (b *AtomicBitSet) TestAndSet(idx int) bool {
+	if !b.inRange(idx) {
+		return false
+	}
+	mask := uint64(1) << (idx % 64)
+	return (b.words[idx/64].Or(mask) & mask) != 0
+}
+
+// TestAndClear unsets the bit and reports whether it was previously set.
+func (b *AtomicBitSet) TestAndClear(idx int) bool {
+	if !b.inRange(idx) {
+		return false
+	}
+	mask := uint64(1) << (idx % 64)
+	return (b.words[idx/64].And(^mask) & mask) != 0
+}
+
+// CompareAndSwap changes the bit to new only if it is currently old.
+func (b *AtomicBitSet) CompareAndSwap(idx int, old, new bool) bool {
+	if !b.inRange(idx) {
+		return false
+	}
+	switch {
+	case old == new:
+		return b.Get(idx) == old
+	case new:
+		return !b.TestAndSet(idx)
+	default:
+		return b.TestAndClear(idx)
+	}
+}
+
+// Count returns the number of set bits. The result is only a snapshot when
+// other goroutines are modifying the set.
+func (b *AtomicBitSet) Count() int {
+	var count int
+	for i := range b.words {
+		count += bits.OnesCount64(b.words[i].Load())
+	}
+	return count
+}
+
+// ClaimNext atomically sets the lowest unset bit and returns its index.
+// Returns false if every bit is already set.
+func (b *AtomicBitSet) ClaimNext() (int, bool) {
+	for i := range b.words {
+		valid := ^uint64(0)
+		if rem := b.length - i*64; rem < 64 {
+			valid = (1 << rem) - 1
+		}
+
+		for {
+			old := b.words[i].Load()
+			free := ^old & valid
+			if free == 0 {
+				break
+			}
+
+			bit := bits.TrailingZeros64(free)
+			if b.words[i].CompareAndSwap(old, old|(1<<bit)) {
+				return i*64 + bit, true
+			}
+		}
+	}
+
+	return 0, false
+}
@@ -0,0 +1,216 @@
+package bitset_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/jdavasligil/golang-dsa/bitset"
+)
+
+func TestAtomicBitSet64(t *testing.T) {
+	t.Run("SetUnsetGet", func(t *testing.T) {
+		var b bitset.AtomicBitSet64
+
+		b.Set(0)
+		b.Set(63)
+		b.Set(255)
+		if b.Load() != 0x8000000000000001 {
+			t.Errorf("Expected %x - Got %x", uint64(0x8000000000000001), uint64(b.Load()))
+		}
+
+		b.Unset(0)
+		if b.Get(0) || !b.Get(63) {
+			t.Errorf("Unset failed. Got %x", uint64(b.Load()))
+		}
+	})
+
+	t.Run("TestAndSetClear", func(t *testing.T) {
+		var b bitset.AtomicBitSet64
+
+		if b.TestAndSet(5) {
+			t.Error("TestAndSet on unset bit should return false")
+		}
+		if !b.TestAndSet(5) {
+			t.Error("TestAndSet on set bit should return true")
+		}
+		if !b.TestAndClear(5) {
+			t.Error("TestAndClear on set bit should return true")
+		}
+		if b.TestAndClear(5) {
+			t.Error("TestAndClear on unset bit should return false")
+		}
+	})
+
+	t.Run("CompareAndSwap", func(t *testing.T) {
+		var b bitset.AtomicBitSet64
+		b.Store(0b1010)
+
+		if b.CompareAndSwap(0b0101, 0b1111) {
+			t.Error("CompareAndSwap succeeded with stale old value")
+		}
+		if !b.CompareAndSwap(0b1010, 0b1111) {
+			t.Error("CompareAndSwap failed with current old value")
+		}
+		if b.Load() != 0b1111 {
+			t.Errorf("Expected %b - Got %b", 0b1111, b.Load())
+		}
+	})
+
+	t.Run("ClaimNext", func(t *testing.T) {
+		var b bitset.AtomicBitSet64
+		b.Store(0b0111)
+
+		idx, ok := b.ClaimNext()
+		if !ok || idx != 3 {
+			t.Errorf("Expected 3 - Got %d (%v)", idx, ok)
+		}
+
+		b.Store(^bitset.BitSet64(0))
+		if _, ok := b.ClaimNext(); ok {
+			t.Error("ClaimNext on a full set should fail")
+		}
+	})
+
+	t.Run("Concurrent", func(t *testing.T) {
+		var b bitset.AtomicBitSet64
+		var wg sync.WaitGroup
+		claimed := make([]int, 64)
+		var mu sync.Mutex
+
+		for range 8 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for {
+					idx, ok := b.ClaimNext()
+					if !ok {
+						return
+					}
+					mu.Lock()
+					claimed[idx]++
+					mu.Unlock()
+				}
+			}()
+		}
+		wg.Wait()
+
+		for i, c := range claimed {
+			if c != 1 {
+				t.Errorf("Bit %d claimed %d times", i, c)
+			}
+		}
+	})
+}
+
+func TestAtomicBitSet(t *testing.T) {
+	t.Run("SetUnsetGet", func(t *testing.T) {
+		b := bitset.NewAtomicBitSet(130)
+
+		tests := []struct {
+			idx      int
+			expected bool
+		}{
+			{idx: 0, expected: true},
+			{idx: 64, expected: true},
+			{idx: 129, expected: true},
+			{idx: 130, expected: false},
+			{idx: -1, expected: false},
+		}
+
+		for i, test := range tests {
+			b.Set(test.idx)
+			if b.Get(test.idx) != test.expected {
+				t.Errorf("Test %d failed. Expected %v - Got %v", i, test.expected, b.Get(test.idx))
+			}
+		}
+
+		if b.Count() != 3 {
+			t.Errorf("Expected count 3 - Got %d", b.Count())
+		}
+
+		b.Unset(64)
+		if b.Get(64) {
+			t.Error("Unset failed")
+		}
+	})
+
+	t.Run("CompareAndSwap", func(t *testing.T) {
+		b := bitset.NewAtomicBitSet(100)
+
+		if !b.CompareAndSwap(70, false, true) || !b.Get(70) {
+			t.Error("CompareAndSwap false->true failed")
+		}
+		if b.CompareAndSwap(70, false, true) {
+			t.Error("CompareAndSwap succeeded on stale value")
+		}
+		if !b.CompareAndSwap(70, true, true) {
+			t.Error("CompareAndSwap true->true should succeed when set")
+		}
+		if !b.CompareAndSwap(70, true, false) || b.Get(70) {
+			t.Error("CompareAndSwap true->false failed")
+		}
+	})
+
+	t.Run("ClaimNextBounds", func(t *testing.T) {
+		b := bitset.NewAtomicBitSet(70)
+
+		for i := range 70 {
+			idx, ok := b.ClaimNext()
+			if !ok || idx != i {
+				t.Fatalf("Expected %d - Got %d (%v)", i, idx, ok)
+			}
+		}
+
+		if idx, ok := b.ClaimNext(); ok {
+			t.Errorf("ClaimNext claimed out of range bit %d", idx)
+		}
+	})
+
+	t.Run("Concurrent", func(t *testing.T) {
+		const length = 1000
+		b := bitset.NewAtomicBitSet(length)
+		var wg sync.WaitGroup
+		results := make([][]int, 8)
+
+		for g := range results {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for {
+					idx, ok := b.ClaimNext()
+					if !ok {
+						return
+					}
+					results[g] = append(results[g], idx)
+				}
+			}()
+		}
+		wg.Wait()
+
+		seen := make([]bool, length)
+		for _, r := range results {
+			for _, idx := range r {
+				if seen[idx] {
+					t.Errorf("Bit %d claimed more than once", idx)
+				}
+				seen[idx] = true
+			}
+		}
+		if b.Count() != length {
+			t.Errorf("Expected count %d - Got %d", length, b.Count())
+		}
+
+		for i := range length {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				b.TestAndClear(i)
+			}()
+		}
+		wg.Wait()
+
+		if b.Count() != 0 {
+			t.Errorf("Expected empty set - Got count %d", b.Count())
+		}
+	})
+}
@@ -0,0 +1,83 @@
+package bitset
+
+import (
+	"iter"
+	"math/bits"
+)
+
+// BitSet is a dense bitset of arbitrary length which grows as bits are set.
+// Negative indices are ignored.
+type BitSet struct {
+	words []uint64
+}
+
+func NewBitSet(length int) *BitSet {
+	return &BitSet{words: make([]uint64, (max(0, length)+63)/64)}
+}
+
+// Len returns the number of bits currently addressable without growing.
+func (b *BitSet) Len() int {
+	return len(b.words) * 64
+}
+
+func (b *BitSet) grow(idx int) {
+	if need := idx/64 + 1; need > len(b.words) {
+		b.words = append(b.words, make([]uint64, need-len(b.words))...)
+	}
+}
+
+func (b *BitSet) Set(idx int) {
+	if idx < 0 {
+		return
+	}
+	b.grow(idx)
+	b.words[idx/64] |= 1 << (idx % 64)
+}
+
+func (b *BitSet) Unset(idx int) {
+	if idx < 0 || idx/64 >= len(b.words) {
+		return
+	}
+	b.words[idx/64] &= ^(uint64(1) << (idx % 64))
+}
+
+func (b *BitSet) Get(idx int) bool {
+	if idx < 0 || idx/64 >= len(b.words) {
+		return false
+	}
+	return (b.words[idx/64] & (1 << (idx % 64))) != 0
+}
+
+// Count returns the number of set bits.
+func (b *BitSet) Count() int {
+	var count int
+	for _, w := range b.words {
+		count += bits.OnesCount64(w)
+	}
+	return count
+}
+
+// All iterates over the indices of set bits in ascending order.
+func (b *BitSet) All() iter.Seq[int] {
+	return func(yield func(int) bool) {
+		for i, w := range b.words {
+			for w != 0 {
+				bit := bits.TrailingZeros64(w)
+				if !yield(i*64 + bit) {
+					return
+				}
+				w &= w - 1
+			}
+		}
+	}
+}
+
+// Or sets every bit which is set in other, growing if needed.
+func (b *BitSet) Or(other *BitSet) {
+	if len(other.words) > len(b.words) {
+		b.words = append(b.words, make([]uint64, len(other.words)-len(b.words))...)
+	}
+	for i, w := range other.words {
+		b.words[i] |= w
+	}
+}
@@ -0,0 +1,69 @@
+package bitset_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/jdavasligil/golang-dsa/bitset"
+)
+
+func TestBitSet(t *testing.T) {
+	t.Run("SetUnsetGet", func(t *testing.T) {
+		b := bitset.NewBitSet(0)
+
+		tests := []struct {
+			idx      int
+			expected bool
+		}{
+			{idx: 0, expected: true},
+			{idx: 63, expected: true},
+			{idx: 64, expected: true},
+			{idx: 1000, expected: true},
+			{idx: -5, expected: false},
+		}
+
+		for i, test := range tests {
+			b.Set(test.idx)
+			if b.Get(test.idx) != test.expected {
+				t.Errorf("Test %d failed. Expected %v - Got %v", i, test.expected, b.Get(test.idx))
+			}
+		}
+
+		if b.Len() != 1024 {
+			t.Errorf("Expected length 1024 - Got %d", b.Len())
+		}
+
+		b.Unset(63)
+		b.Unset(5000)
+		if b.Get(63) || b.Count() != 3 {
+			t.Errorf("Unset failed. Count %d", b.Count())
+		}
+	})
+
+	t.Run("All", func(t *testing.T) {
+		b := bitset.NewBitSet(200)
+		expected := []int{1, 2, 64, 130, 199}
+		for _, idx := range expected {
+			b.Set(idx)
+		}
+
+		got := slices.Collect(b.All())
+		if !slices.Equal(got, expected) {
+			t.Errorf("Expected %v - Got %v", expected, got)
+		}
+	})
+
+	t.Run("Or", func(t *testing.T) {
+		a := bitset.NewBitSet(64)
+		b := bitset.NewBitSet(0)
+		a.Set(1)
+		b.Set(1)
+		b.Set(100)
+
+		a.Or(b)
+		expected := []int{1, 100}
+		if got := slices.Collect(a.All()); !slices.Equal(got, expected) {
+			t.Errorf("Expected %v - Got %v", expected, got)
+		}
+	})
+}
@@ -0,0 +1,514 @@
+package bitset
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync/atomic"
+)
+
+// Largest index accepted when decoding JSON, so that a short document cannot
+// force a huge allocation. 2^28 bits take 32 MiB.
+const maxJSONIndex = 1<<28 - 1
+
+type word interface {
+	~uint8 | ~uint16 | ~uint32 | ~uint64
+}
+
+// Parse "0b" binary or "0x" hex digits into little-endian words.
+func parseWords(s string) ([]uint64, error) {
+	var bitsPerDigit, base int
+	switch {
+	case strings.HasPrefix(s, "0b") || strings.HasPrefix(s, "0B"):
+		bitsPerDigit, base = 1, 2
+	case strings.HasPrefix(s, "0x") || strings.HasPrefix(s, "0X"):
+		bitsPerDigit, base = 4, 16
+	default:
+		return nil, &BitSetFormatError{fmt.Sprintf("%q must start with 0b or 0x", s)}
+	}
+
+	digits := s[2:]
+	if digits == "" {
+		return nil, &BitSetFormatError{fmt.Sprintf("%q has no digits", s)}
+	}
+
+	words := make([]uint64, (len(digits)*bitsPerDigit+63)/64)
+	for i := range len(digits) {
+		d, err := strconv.ParseUint(digits[len(digits)-1-i:len(digits)-i], base, 8)
+		if err != nil {
+			return nil, &BitSetFormatError{fmt.Sprintf("%q has invalid digit %q", s, digits[len(digits)-1-i])}
+		}
+		pos := i * bitsPerDigit
+		words[pos/64] |= d << (pos % 64)
+	}
+
+	return words, nil
+}
+
+func parseWord[W word](s string, width int) (W, error) {
+	words, err := parseWords(s)
+	if err != nil {
+		return 0, err
+	}
+	for i, w := range words {
+		if (i == 0 && width < 64 && w>>width != 0) || (i > 0 && w != 0) {
+			return 0, &BitSetFormatError{fmt.Sprintf("%q overflows %d bits", s, width)}
+		}
+	}
+	return W(words[0]), nil
+}
+
+func formatWord[W word](w W, width int) string {
+	return fmt.Sprintf("0b%0*b", width, uint64(w))
+}
+
+func appendWord[W word](buf []byte, w W, width int) []byte {
+	for i := 0; i < width; i += 8 {
+		buf = append(buf, byte(uint64(w)>>i))
+	}
+	return buf
+}
+
+func decodeWord[W word](data []byte, width int) (W, error) {
+	if len(data) != width/8 {
+		return 0, &BitSetFormatError{fmt.Sprintf("expected %d bytes, got %d", width/8, len(data))}
+	}
+	var w uint64
+	for i, b := range data {
+		w |= uint64(b) << (8 * i)
+	}
+	return W(w), nil
+}
+
+// Fixed width bitsets are written to JSON as hex strings.
+func marshalWordJSON[W word](w W, width int) ([]byte, error) {
+	return json.Marshal(fmt.Sprintf("0x%0*x", width/4, uint64(w)))
+}
+
+// Accepts either a hex or binary string, or a list of set bit indices.
+func unmarshalWordJSON[W word](data []byte, width int) (W, error) {
+	data = bytes.TrimSpace(data)
+	if len(data) > 0 && data[0] == '[' {
+		var indices []int
+		if err := json.Unmarshal(data, &indices); err != nil {
+			return 0, &BitSetFormatError{err.Error()}
+		}
+		var w uint64
+		for _, idx := range indices {
+			if idx < 0 || idx >= width {
+				return 0, &BitSetFormatError{fmt.Sprintf("index %d out of range for %d bits", idx, width)}
+			}
+			w |= 1 << idx
+		}
+		return W(w), nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return 0, &BitSetFormatError{err.Error()}
+	}
+	return parseWord[W](s, width)
+}
+
+// String returns the bits most significant first, e.g. "0b00000101".
+func (b BitSet8) String() string {
+	return formatWord(b, 8)
+}
+
+// ParseBitSet8 accepts binary ("0b101") or hex ("0x05") strings.
+func ParseBitSet8(s string) (BitSet8, error) {
+	return parseWord[BitSet8](s, 8)
+}
+
+func (b BitSet8) MarshalBinary() ([]byte, error) {
+	return appendWord(nil, b, 8), nil
+}
+
+func (b *BitSet8) UnmarshalBinary(data []byte) (err error) {
+	*b, err = decodeWord[BitSet8](data, 8)
+	return err
+}
+
+func (b BitSet8) MarshalJSON() ([]byte, error) {
+	return marshalWordJSON(b, 8)
+}
+
+func (b *BitSet8) UnmarshalJSON(data []byte) (err error) {
+	*b, err = unmarshalWordJSON[BitSet8](data, 8)
+	return err
+}
+
+// String returns the bits most significant first.
+func (b BitSet16) String() string {
+	return formatWord(b, 16)
+}
+
+// ParseBitSet16 accepts binary ("0b101") or hex ("0x05") strings.
+func ParseBitSet16(s string) (BitSet16, error) {
+	return parseWord[BitSet16](s, 16)
+}
+
+func (b BitSet16) MarshalBinary() ([]byte, error) {
+	return appendWord(nil, b, 16), nil
+}
+
+func (b *BitSet16) UnmarshalBinary(data []byte) (err error) {
+	*b, err = decodeWord[BitSet16](data, 16)
+	return err
+}
+
+func (b BitSet16) MarshalJSON() ([]byte, error) {
+	return marshalWordJSON(b, 16)
+}
+
+func (b *BitSet16) UnmarshalJSON(data []byte) (err error) {
+	*b, err = unmarshalWordJSON[BitSet16](data, 16)
+	return err
+}
+
+// String returns the bits most significant first.
+func (b BitSet32) String() string {
+	return formatWord(b, 32)
+}
+
+// ParseBitSet32 accepts binary ("0b101") or hex ("0x05") strings.
+func ParseBitSet32(s string) (BitSet32, error) {
+	return parseWord[BitSet32](s, 32)
+}
+
+func (b BitSet32) MarshalBinary() ([]byte, error) {
+	return appendWord(nil, b, 32), nil
+}
+
+func (b *BitSet32) UnmarshalBinary(data []byte) (err error) {
+	*b, err = decodeWord[BitSet32](data, 32)
+	return err
+}
+
+func (b BitSet32) MarshalJSON() ([]byte, error) {
+	return marshalWordJSON(b, 32)
+}
+
+func (b *BitSet32) UnmarshalJSON(data []byte) (err error) {
+	*b, err = unmarshalWordJSON[BitSet32](data, 32)
+	return err
+}
+
+// String returns the bits most significant first.
+func (b BitSet64) String() string {
+	return formatWord(b, 64)
+}
+
+// ParseBitSet64 accepts binary ("0b101") or hex ("0x05") strings.
+func ParseBitSet64(s string) (BitSet64, error) {
+	return parseWord[BitSet64](s, 64)
+}
+
+func (b BitSet64) MarshalBinary() ([]byte, error) {
+	return appendWord(nil, b, 64), nil
+}
+
+func (b *BitSet64) UnmarshalBinary(data []byte) (err error) {
+	*b, err = decodeWord[BitSet64](data, 64)
+	return err
+}
+
+func (b BitSet64) MarshalJSON() ([]byte, error) {
+	return marshalWordJSON(b, 64)
+}
+
+func (b *BitSet64) UnmarshalJSON(data []byte) (err error) {
+	*b, err = unmarshalWordJSON[BitSet64](data, 64)
+	return err
+}
+
+// String returns the bits most significant first without leading zeros,
+// e.g. "0b101". An empty set is "0b0".
+func (b *BitSet) String() string {
+	top := len(b.words) - 1
+	for top >= 0 && b.words[top] == 0 {
+		top--
+	}
+	if top < 0 {
+		return "0b0"
+	}
+
+	var sb strings.Builder
+	sb.WriteString("0b")
+	sb.WriteString(strconv.FormatUint(b.words[top], 2))
+	for i := top - 1; i >= 0; i-- {
+		sb.WriteString(fmt.Sprintf("%064b", b.words[i]))
+	}
+
+	return sb.String()
+}
+
+// ParseBitSet accepts binary ("0b101") or hex ("0x05") strings of any length.
+func ParseBitSet(s string) (*BitSet, error) {
+	words, err := parseWords(s)
+	if err != nil {
+		return nil, err
+	}
+	return &BitSet{words: words}, nil
+}
+
+// MarshalBinary writes each 64 bit word in little-endian order, lowest word
+// first.
+func (b *BitSet) MarshalBinary() ([]byte, error) {
+	buf := make([]byte, 0, 8*len(b.words))
+	for _, w := range b.words {
+		buf = binary.LittleEndian.AppendUint64(buf, w)
+	}
+	return buf, nil
+}
+
+func (b *BitSet) UnmarshalBinary(data []byte) error {
+	if len(data)%8 != 0 {
+		return &BitSetFormatError{fmt.Sprintf("length %d is not a multiple of 8", len(data))}
+	}
+	words := make([]uint64, len(data)/8)
+	for i := range words {
+		words[i] = binary.LittleEndian.Uint64(data[8*i:])
+	}
+	b.words = words
+	return nil
+}
+
+// MarshalJSON writes the indices of the set bits, e.g. [0,2,130].
+func (b *BitSet) MarshalJSON() ([]byte, error) {
+	indices := make([]int, 0, b.Count())
+	for idx := range b.All() {
+		indices = append(indices, idx)
+	}
+	return json.Marshal(indices)
+}
+
+// UnmarshalJSON accepts either a list of set bit indices or a hex or binary
+// string. Indices above 2^28 - 1 are rejected.
+func (b *BitSet) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if len(data) > 0 && data[0] == '[' {
+		var indices []int
+		if err := json.Unmarshal(data, &indices); err != nil {
+			return &BitSetFormatError{err.Error()}
+		}
+		parsed := &BitSet{}
+		for _, idx := range indices {
+			if idx < 0 || idx > maxJSONIndex {
+				return &BitSetFormatError{fmt.Sprintf("index %d out of range [0, %d]", idx, maxJSONIndex)}
+			}
+			parsed.Set(idx)
+		}
+		b.words = parsed.words
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return &BitSetFormatError{err.Error()}
+	}
+	parsed, err := ParseBitSet(s)
+	if err != nil {
+		return err
+	}
+	b.words = parsed.words
+	return nil
+}
+
+func (b *AtomicBitSet64) MarshalBinary() ([]byte, error) {
+	return b.Load().MarshalBinary()
+}
+
+func (b *AtomicBitSet64) UnmarshalBinary(data []byte) error {
+	val, err := decodeWord[BitSet64](data, 64)
+	if err == nil {
+		b.Store(val)
+	}
+	return err
+}
+
+func (b *AtomicBitSet64) MarshalJSON() ([]byte, error) {
+	return b.Load().MarshalJSON()
+}
+
+func (b *AtomicBitSet64) UnmarshalJSON(data []byte) error {
+	val, err := unmarshalWordJSON[BitSet64](data, 64)
+	if err == nil {
+		b.Store(val)
+	}
+	return err
+}
+
+// Fixed length sets are written to JSON with their length, which the set
+// bits alone would not preserve, e.g. {"length":100,"bits":[3,70]}.
+type sizedJSON struct {
+	Length int   `json:"length"`
+	Bits   []int `json:"bits"`
+}
+
+func marshalSizedJSON(words []uint64, length int) ([]byte, error) {
+	indices := []int{}
+	for idx := range (&BitSet{words: words}).All() {
+		indices = append(indices, idx)
+	}
+	return json.Marshal(sizedJSON{Length: length, Bits: indices})
+}
+
+func unmarshalSizedJSON(data []byte) ([]uint64, int, error) {
+	var v sizedJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return nil, 0, &BitSetFormatError{err.Error()}
+	}
+	if v.Length < 0 || v.Length > maxJSONIndex+1 {
+		return nil, 0, &BitSetFormatError{fmt.Sprintf("length %d out of range [0, %d]", v.Length, maxJSONIndex+1)}
+	}
+
+	words := make([]uint64, (v.Length+63)/64)
+	for _, idx := range v.Bits {
+		if idx < 0 || idx >= v.Length {
+			return nil, 0, &BitSetFormatError{fmt.Sprintf("index %d out of range for %d bits", idx, v.Length)}
+		}
+		words[idx/64] |= 1 << (idx % 64)
+	}
+	return words, v.Length, nil
+}
+
+// MarshalBinary writes the length as a little-endian uint64 followed by the
+// words as for BitSet. The result is only a snapshot when other goroutines
+// are modifying the set.
+func (b *AtomicBitSet) MarshalBinary() ([]byte, error) {
+	buf := make([]byte, 0, 8+8*len(b.words))
+	buf = binary.LittleEndian.AppendUint64(buf, uint64(b.length))
+	for i := range b.words {
+		buf = binary.LittleEndian.AppendUint64(buf, b.words[i].Load())
+	}
+	return buf, nil
+}
+
+// UnmarshalBinary replaces the set, including its length. It must not run
+// concurrently with other methods.
+func (b *AtomicBitSet) UnmarshalBinary(data []byte) error {
+	if len(data) < 8 {
+		return &BitSetFormatError{fmt.Sprintf("expected at least 8 bytes, got %d", len(data))}
+	}
+	var words BitSet
+	if err := words.UnmarshalBinary(data[8:]); err != nil {
+		return err
+	}
+
+	length, n := binary.LittleEndian.Uint64(data), uint64(len(words.words))
+	if length > n*64 || (length+63)/64 != n {
+		return &BitSetFormatError{fmt.Sprintf("length %d does not match %d words", length, n)}
+	}
+	if rem := length % 64; rem != 0 && words.words[n-1]>>rem != 0 {
+		return &BitSetFormatError{fmt.Sprintf("bits set beyond length %d", length)}
+	}
+
+	b.store(words.words, int(length))
+	return nil
+}
+
+// MarshalJSON writes the length and the indices of the set bits, e.g.
+// {"length":100,"bits":[3,70]}. The result is only a snapshot when other
+// goroutines are modifying the set.
+func (b *AtomicBitSet) MarshalJSON() ([]byte, error) {
+	words := make([]uint64, len(b.words))
+	for i := range b.words {
+		words[i] = b.words[i].Load()
+	}
+	return marshalSizedJSON(words, b.length)
+}
+
+// UnmarshalJSON replaces the set, including its length. It must not run
+// concurrently with other methods.
+func (b *AtomicBitSet) UnmarshalJSON(data []byte) error {
+	words, length, err := unmarshalSizedJSON(data)
+	if err != nil {
+		return err
+	}
+	b.store(words, length)
+	return nil
+}
+
+func (b *AtomicBitSet) store(words []uint64, length int) {
+	b.words = make([]atomic.Uint64, len(words))
+	for i, w := range words {
+		b.words[i].Store(w)
+	}
+	b.length = length
+}
+
+// MarshalBinary writes the bits in the same format as BitSet. The rank and
+// select indexes are rebuilt when decoding.
+func (r *RankSelect) MarshalBinary() ([]byte, error) {
+	return (&BitSet{words: r.words}).MarshalBinary()
+}
+
+func (r *RankSelect) UnmarshalBinary(data []byte) error {
+	var b BitSet
+	if err := b.UnmarshalBinary(data); err != nil {
+		return err
+	}
+	*r = *NewRankSelect(&b)
+	return nil
+}
+
+// MarshalJSON writes the length and the indices of the set bits, e.g.
+// {"length":128,"bits":[3,70]}.
+func (r *RankSelect) MarshalJSON() ([]byte, error) {
+	return marshalSizedJSON(r.words, r.length)
+}
+
+func (r *RankSelect) UnmarshalJSON(data []byte) error {
+	words, length, err := unmarshalSizedJSON(data)
+	if err != nil {
+		return err
+	}
+	if length%64 != 0 {
+		return &BitSetFormatError{fmt.Sprintf("length %d is not a multiple of 64", length)}
+	}
+	*r = *NewRankSelect(&BitSet{words: words})
+	return nil
+}
+
+// String lists the values in ascending order, e.g. "{1, 5, 70000}".
+func (r *Roaring) String() string {
+	var sb strings.Builder
+	sb.WriteByte('{')
+	for v := range r.All() {
+		if sb.Len() > 1 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(strconv.FormatUint(uint64(v), 10))
+	}
+	sb.WriteByte('}')
+	return sb.String()
+}
+
+// MarshalJSON writes the values in ascending order, e.g. [1,5,70000].
+func (r *Roaring) MarshalJSON() ([]byte, error) {
+	values := make([]uint32, 0, r.Cardinality())
+	for v := range r.All() {
+		values = append(values, v)
+	}
+	return json.Marshal(values)
+}
+
+// UnmarshalJSON accepts a list of values in any order, replacing the current
+// contents.
+func (r *Roaring) UnmarshalJSON(data []byte) error {
+	var values []uint32
+	if err := json.Unmarshal(data, &values); err != nil {
+		return &BitSetFormatError{err.Error()}
+	}
+
+	parsed := NewRoaring()
+	for _, v := range values {
+		parsed.Add(v)
+	}
+	*r = *parsed
+	return nil
+}
@@ -0,0 +1,292 @@
+package bitset_test
+
+import (
+	"encoding/json"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/jdavasligil/golang-dsa/bitset"
+)
+
+func TestBitSetEncoding(t *testing.T) {
+	t.Run("String", func(t *testing.T) {
+		tests := []struct {
+			got      string
+			expected string
+		}{
+			{got: bitset.BitSet8(0b101).String(), expected: "0b00000101"},
+			{got: bitset.BitSet16(0x8001).String(), expected: "0b1000000000000001"},
+			{got: bitset.NewBitSet(128).String(), expected: "0b0"},
+		}
+
+		dense := bitset.NewBitSet(0)
+		dense.Set(0)
+		dense.Set(65)
+		tests = append(tests, struct {
+			got      string
+			expected string
+		}{got: dense.String(), expected: "0b10" + "000000000000000000000000000000000000000000000000000000000000000" + "1"})
+
+		for i, test := range tests {
+			if test.got != test.expected {
+				t.Errorf("Test %d failed. Expected %s - Got %s", i, test.expected, test.got)
+			}
+		}
+	})
+
+	t.Run("Parse", func(t *testing.T) {
+		tests := []struct {
+			s        string
+			expected bitset.BitSet8
+			ok       bool
+		}{
+			{s: "0b1010", expected: 0b1010, ok: true},
+			{s: "0xff", expected: 0xff, ok: true},
+			{s: "0XA0", expected: 0xa0, ok: true},
+			{s: "0x000001", expected: 1, ok: true},
+			{s: "0x100", ok: false},
+			{s: "0b102", ok: false},
+			{s: "1010", ok: false},
+			{s: "0x", ok: false},
+		}
+
+		for i, test := range tests {
+			got, err := bitset.ParseBitSet8(test.s)
+			if test.ok && (err != nil || got != test.expected) {
+				t.Errorf("Test %d failed. Expected %v - Got %v (%v)", i, test.expected, got, err)
+			}
+			if !test.ok && !errors.Is(err, &bitset.BitSetFormatError{}) {
+				t.Errorf("Test %d: Got %v  Expected: BitSetFormatError.", i, err)
+			}
+		}
+	})
+
+	t.Run("Binary", func(t *testing.T) {
+		b := bitset.BitSet32(0x04030201)
+		data, _ := b.MarshalBinary()
+		if !slices.Equal(data, []byte{1, 2, 3, 4}) {
+			t.Errorf("Expected little-endian bytes - Got %v", data)
+		}
+
+		var decoded bitset.BitSet32
+		if err := decoded.UnmarshalBinary(data[:3]); !errors.Is(err, &bitset.BitSetFormatError{}) {
+			t.Errorf("Got: %v  Expected: BitSetFormatError.", err)
+		}
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		data, err := json.Marshal(bitset.BitSet16(0x00f0))
+		if err != nil || string(data) != `"0x00f0"` {
+			t.Errorf("Expected \"0x00f0\" - Got %s (%v)", data, err)
+		}
+
+		var fixed bitset.BitSet16
+		if err := json.Unmarshal([]byte(`[0, 4, 15]`), &fixed); err != nil || fixed != 0x8011 {
+			t.Errorf("Expected 0x8011 - Got %x (%v)", uint16(fixed), err)
+		}
+		if err := json.Unmarshal([]byte(`[16]`), &fixed); !errors.Is(err, &bitset.BitSetFormatError{}) {
+			t.Errorf("Got: %v  Expected: BitSetFormatError.", err)
+		}
+
+		dense := bitset.NewBitSet(0)
+		dense.Set(3)
+		dense.Set(200)
+		data, err = json.Marshal(dense)
+		if err != nil || string(data) != `[3,200]` {
+			t.Errorf("Expected [3,200] - Got %s (%v)", data, err)
+		}
+
+		decoded := bitset.NewBitSet(0)
+		if err := json.Unmarshal([]byte(`"0x18"`), decoded); err != nil || !decoded.Get(3) || !decoded.Get(4) {
+			t.Errorf("Hex decode failed: %v", err)
+		}
+	})
+
+	t.Run("JSONBounds", func(t *testing.T) {
+		decoded := bitset.NewBitSet(0)
+		for _, data := range []string{`[-1]`, `[268435456]`, `[9007199254740991]`} {
+			if err := json.Unmarshal([]byte(data), decoded); !errors.Is(err, &bitset.BitSetFormatError{}) {
+				t.Errorf("%s Got: %v  Expected: BitSetFormatError.", data, err)
+			}
+		}
+		if err := json.Unmarshal([]byte(`[268435455]`), decoded); err != nil || !decoded.Get(268435455) {
+			t.Errorf("Largest index rejected: %v", err)
+		}
+	})
+
+	t.Run("Atomic64", func(t *testing.T) {
+		var b bitset.AtomicBitSet64
+		b.Store(0x8001)
+
+		data, _ := b.MarshalBinary()
+		var decoded bitset.AtomicBitSet64
+		if err := decoded.UnmarshalBinary(data); err != nil || decoded.Load() != 0x8001 {
+			t.Errorf("Binary round trip: Expected 0x8001 - Got %v (%v)", decoded.Load(), err)
+		}
+
+		data, err := json.Marshal(&b)
+		if err != nil || string(data) != `"0x0000000000008001"` {
+			t.Errorf("Expected \"0x0000000000008001\" - Got %s (%v)", data, err)
+		}
+		if err := json.Unmarshal([]byte(`[1, 63]`), &decoded); err != nil || decoded.Load() != 1<<63|1<<1 {
+			t.Errorf("Expected bits 1 and 63 - Got %v (%v)", decoded.Load(), err)
+		}
+		if err := json.Unmarshal([]byte(`[64]`), &decoded); !errors.Is(err, &bitset.BitSetFormatError{}) {
+			t.Errorf("Got: %v  Expected: BitSetFormatError.", err)
+		}
+	})
+
+	t.Run("Atomic", func(t *testing.T) {
+		b := bitset.NewAtomicBitSet(100)
+		b.Set(3)
+		b.Set(99)
+
+		data, err := json.Marshal(b)
+		if err != nil || string(data) != `{"length":100,"bits":[3,99]}` {
+			t.Errorf("Expected {\"length\":100,\"bits\":[3,99]} - Got %s (%v)", data, err)
+		}
+		var decoded bitset.AtomicBitSet
+		if err := json.Unmarshal(data, &decoded); err != nil || decoded.Len() != 100 || decoded.Count() != 2 || !decoded.Get(99) {
+			t.Errorf("JSON round trip: Len %d Count %d (%v)", decoded.Len(), decoded.Count(), err)
+		}
+		if err := json.Unmarshal([]byte(`{"length":100,"bits":[100]}`), &decoded); !errors.Is(err, &bitset.BitSetFormatError{}) {
+			t.Errorf("Got: %v  Expected: BitSetFormatError.", err)
+		}
+
+		data, _ = b.MarshalBinary()
+		decoded = bitset.AtomicBitSet{}
+		if err := decoded.UnmarshalBinary(data); err != nil || decoded.Len() != 100 || decoded.Count() != 2 || !decoded.Get(3) {
+			t.Errorf("Binary round trip: Len %d Count %d (%v)", decoded.Len(), decoded.Count(), err)
+		}
+
+		// Bit 100 lies beyond the length.
+		data[8+8+100%64/8] |= 1 << (100 % 8)
+		if err := decoded.UnmarshalBinary(data); !errors.Is(err, &bitset.BitSetFormatError{}) {
+			t.Errorf("Got: %v  Expected: BitSetFormatError.", err)
+		}
+		if err := decoded.UnmarshalBinary(data[:8]); !errors.Is(err, &bitset.BitSetFormatError{}) {
+			t.Errorf("Got: %v  Expected: BitSetFormatError.", err)
+		}
+	})
+
+	t.Run("RankSelect", func(t *testing.T) {
+		dense := bitset.NewBitSet(192)
+		dense.Set(3)
+		dense.Set(70)
+		r := bitset.NewRankSelect(dense)
+
+		data, err := json.Marshal(r)
+		if err != nil || string(data) != `{"length":192,"bits":[3,70]}` {
+			t.Errorf("Expected {\"length\":192,\"bits\":[3,70]} - Got %s (%v)", data, err)
+		}
+		var decoded bitset.RankSelect
+		if err := json.Unmarshal(data, &decoded); err != nil || decoded.Len() != 192 || decoded.Rank1(71) != 2 {
+			t.Errorf("JSON round trip: Len %d Rank1(71) %d (%v)", decoded.Len(), decoded.Rank1(71), err)
+		}
+		if err := json.Unmarshal([]byte(`{"length":100,"bits":[]}`), &decoded); !errors.Is(err, &bitset.BitSetFormatError{}) {
+			t.Errorf("Got: %v  Expected: BitSetFormatError.", err)
+		}
+
+		data, _ = r.MarshalBinary()
+		decoded = bitset.RankSelect{}
+		if err := decoded.UnmarshalBinary(data); err != nil || decoded.Len() != 192 || decoded.Ones() != 2 {
+			t.Errorf("Binary round trip: Len %d Ones %d (%v)", decoded.Len(), decoded.Ones(), err)
+		}
+		if idx, ok := decoded.Select1(1); !ok || idx != 70 {
+			t.Errorf("Select1(1) = %d (%t) != expected 70", idx, ok)
+		}
+	})
+}
+
+func FuzzBitSetRoundTrip(f *testing.F) {
+	f.Add([]byte{})
+	f.Add([]byte{1, 2, 3, 4, 5, 6, 7, 8})
+	f.Add([]byte{0xff, 0, 0, 0, 0, 0, 0, 0x80, 1, 0, 0, 0, 0, 0, 0, 0})
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		data = data[:len(data)/8*8]
+
+		b := bitset.NewBitSet(0)
+		if err := b.UnmarshalBinary(data); err != nil {
+			t.Fatal(err)
+		}
+		expected := slices.Collect(b.All())
+
+		encoded, _ := b.MarshalBinary()
+		if !slices.Equal(encoded, data) {
+			t.Fatalf("Binary round trip changed bytes")
+		}
+
+		parsed, err := bitset.ParseBitSet(b.String())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := slices.Collect(parsed.All()); !slices.Equal(got, expected) {
+			t.Fatalf("String round trip: Expected %v - Got %v", expected, got)
+		}
+
+		js, err := json.Marshal(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		decoded := bitset.NewBitSet(0)
+		if err := json.Unmarshal(js, decoded); err != nil {
+			t.Fatal(err)
+		}
+		if got := slices.Collect(decoded.All()); !slices.Equal(got, expected) {
+			t.Fatalf("JSON round trip: Expected %v - Got %v", expected, got)
+		}
+	})
+}
+
+func FuzzBitSet64RoundTrip(f *testing.F) {
+	f.Add(uint64(0))
+	f.Add(uint64(0xdeadbeef))
+	f.Add(^uint64(0))
+
+	f.Fuzz(func(t *testing.T, v uint64) {
+		b := bitset.BitSet64(v)
+
+		parsed, err := bitset.ParseBitSet64(b.String())
+		if err != nil || parsed != b {
+			t.Fatalf("String round trip: Expected %v - Got %v (%v)", b, parsed, err)
+		}
+
+		data, _ := b.MarshalBinary()
+		var decoded bitset.BitSet64
+		if err := decoded.UnmarshalBinary(data); err != nil || decoded != b {
+			t.Fatalf("Binary round trip: Expected %v - Got %v (%v)", b, decoded, err)
+		}
+
+		js, _ := json.Marshal(b)
+		if err := json.Unmarshal(js, &decoded); err != nil || decoded != b {
+			t.Fatalf("JSON round trip: Expected %v - Got %v (%v)", b, decoded, err)
+		}
+	})
+}
+
+func FuzzParseBitSet(f *testing.F) {
+	f.Add("0b1010")
+	f.Add("0xdeadbeef")
+	f.Add("0b")
+	f.Add("garbage")
+
+	f.Fuzz(func(t *testing.T, s string) {
+		b, err := bitset.ParseBitSet(s)
+		if err != nil {
+			if !errors.Is(err, &bitset.BitSetFormatError{}) {
+				t.Fatalf("Unexpected error type %T", err)
+			}
+			return
+		}
+
+		again, err := bitset.ParseBitSet(b.String())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !slices.Equal(slices.Collect(again.All()), slices.Collect(b.All())) {
+			t.Fatalf("Reparse of %q changed contents", s)
+		}
+	})
+}
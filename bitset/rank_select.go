@@ -0,0 +1,140 @@
+package bitset
+
+import (
+	"math/bits"
+	"sort"
+)
+
+// Words per superblock. Block counts are relative to their superblock so
+// they fit in a uint16.
+const superblockWords = 8
+
+// RankSelect is an immutable succinct bitvector answering rank queries in
+// O(1) and select queries in O(log n).
+//
+// Cumulative counts are stored per 512 bit superblock and per 64 bit block,
+// adding roughly 37% space on top of the bits themselves.
+type RankSelect struct {
+	words  []uint64
+	super  []uint64
+	block  []uint16
+	length int
+	ones   int
+}
+
+// NewRankSelect copies the bits of b. Later changes to b are not reflected.
+func NewRankSelect(b *BitSet) *RankSelect {
+	r := &RankSelect{
+		words:  append([]uint64(nil), b.words...),
+		super:  make([]uint64, (len(b.words)+superblockWords-1)/superblockWords),
+		block:  make([]uint16, len(b.words)),
+		length: len(b.words) * 64,
+	}
+
+	var total, relative int
+	for i, w := range r.words {
+		if i%superblockWords == 0 {
+			r.super[i/superblockWords] = uint64(total)
+			relative = 0
+		}
+		r.block[i] = uint16(relative)
+		n := bits.OnesCount64(w)
+		relative += n
+		total += n
+	}
+	r.ones = total
+
+	return r
+}
+
+func (r *RankSelect) Len() int {
+	return r.length
+}
+
+// Ones returns the total number of set bits.
+func (r *RankSelect) Ones() int {
+	return r.ones
+}
+
+func (r *RankSelect) Get(i int) bool {
+	if i < 0 || i >= r.length {
+		return false
+	}
+	return r.words[i/64]&(1<<(i%64)) != 0
+}
+
+// Rank1 returns the number of set bits in [0, i). i is clamped to [0, Len].
+func (r *RankSelect) Rank1(i int) int {
+	if i <= 0 {
+		return 0
+	}
+	if i >= r.length {
+		return r.ones
+	}
+
+	w := i / 64
+	partial := r.words[w] & ((1 << (i % 64)) - 1)
+
+	return int(r.super[w/superblockWords]) + int(r.block[w]) + bits.OnesCount64(partial)
+}
+
+// Rank0 returns the number of unset bits in [0, i). i is clamped to [0, Len].
+func (r *RankSelect) Rank0(i int) int {
+	i = max(0, min(i, r.length))
+	return i - r.Rank1(i)
+}
+
+// Select1 returns the position of the k-th set bit counting from zero, so
+// that Rank1(Select1(k)) == k. Returns false if there are not enough set bits.
+func (r *RankSelect) Select1(k int) (int, bool) {
+	if k < 0 || k >= r.ones {
+		return 0, false
+	}
+
+	return r.selectIn(k,
+		func(sb int) int { return int(r.super[sb]) },
+		func(w uint64) uint64 { return w },
+		func(i int) int { return int(r.block[i]) },
+	)
+}
+
+// Select0 returns the position of the k-th unset bit counting from zero, so
+// that Rank0(Select0(k)) == k. Returns false if there are not enough unset bits.
+func (r *RankSelect) Select0(k int) (int, bool) {
+	if k < 0 || k >= r.length-r.ones {
+		return 0, false
+	}
+
+	return r.selectIn(k,
+		func(sb int) int { return sb*superblockWords*64 - int(r.super[sb]) },
+		func(w uint64) uint64 { return ^w },
+		func(i int) int { return (i%superblockWords)*64 - int(r.block[i]) },
+	)
+}
+
+// Shared select for both bit values. count(sb) gives the matching bits before
+// superblock sb, flip maps a word to one whose set bits are the matching bits,
+// and relative(i) gives the matching bits before word i within its superblock.
+func (r *RankSelect) selectIn(k int, count func(sb int) int, flip func(uint64) uint64, relative func(i int) int) (int, bool) {
+	// Last superblock with fewer than k+1 matching bits before it.
+	sb := sort.Search(len(r.super), func(sb int) bool { return count(sb) > k }) - 1
+	k -= count(sb)
+
+	start := sb * superblockWords
+	end := min(start+superblockWords, len(r.words))
+	w := start
+	for w+1 < end && relative(w+1) <= k {
+		w++
+	}
+	k -= relative(w)
+
+	return w*64 + selectInWord(flip(r.words[w]), k), true
+}
+
+// Position of the k-th set bit of w counting from zero.
+func selectInWord(w uint64, k int) int {
+	for range k {
+		w &= w - 1
+	}
+	return bits.TrailingZeros64(w)
+}
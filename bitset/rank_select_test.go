@@ -0,0 +1,99 @@
+package bitset_test
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"github.com/jdavasligil/golang-dsa/bitset"
+)
+
+func TestRankSelect(t *testing.T) {
+	t.Run("Empty", func(t *testing.T) {
+		r := bitset.NewRankSelect(bitset.NewBitSet(0))
+
+		if r.Rank1(10) != 0 || r.Rank0(10) != 0 {
+			t.Error("Rank on empty bitvector should be zero")
+		}
+		if _, ok := r.Select1(0); ok {
+			t.Error("Select1 on empty bitvector should fail")
+		}
+		if _, ok := r.Select0(0); ok {
+			t.Error("Select0 on empty bitvector should fail")
+		}
+	})
+
+	t.Run("Exhaustive", func(t *testing.T) {
+		rng := rand.New(rand.NewPCG(11, 12))
+		sizes := []int{64, 500, 512, 513, 4096, 5000}
+		densities := []float64{0, 0.01, 0.5, 0.99, 1}
+
+		for _, size := range sizes {
+			for _, density := range densities {
+				b := bitset.NewBitSet(size)
+				for i := range size {
+					if rng.Float64() < density {
+						b.Set(i)
+					}
+				}
+				r := bitset.NewRankSelect(b)
+
+				var ones, zeros int
+				for i := 0; i <= r.Len(); i++ {
+					if got := r.Rank1(i); got != ones {
+						t.Fatalf("Size %d density %.2f: Rank1(%d) = %d != expected %d", size, density, i, got, ones)
+					}
+					if got := r.Rank0(i); got != zeros {
+						t.Fatalf("Size %d density %.2f: Rank0(%d) = %d != expected %d", size, density, i, got, zeros)
+					}
+					if i == r.Len() {
+						break
+					}
+
+					if b.Get(i) {
+						if got, ok := r.Select1(ones); !ok || got != i {
+							t.Fatalf("Size %d density %.2f: Select1(%d) = %d (%v) != expected %d", size, density, ones, got, ok, i)
+						}
+						ones++
+					} else {
+						if got, ok := r.Select0(zeros); !ok || got != i {
+							t.Fatalf("Size %d density %.2f: Select0(%d) = %d (%v) != expected %d", size, density, zeros, got, ok, i)
+						}
+						zeros++
+					}
+				}
+
+				if r.Ones() != ones {
+					t.Errorf("Ones %d != expected %d", r.Ones(), ones)
+				}
+				if _, ok := r.Select1(ones); ok {
+					t.Errorf("Select1(%d) should fail with only %d ones", ones, ones)
+				}
+				if _, ok := r.Select0(zeros); ok {
+					t.Errorf("Select0(%d) should fail with only %d zeros", zeros, zeros)
+				}
+			}
+		}
+	})
+}
+
+func BenchmarkRankSelect(b *testing.B) {
+	rng := rand.New(rand.NewPCG(13, 14))
+	bs := bitset.NewBitSet(1 << 20)
+	for i := range 1 << 20 {
+		if rng.IntN(2) == 0 {
+			bs.Set(i)
+		}
+	}
+	r := bitset.NewRankSelect(bs)
+
+	b.Run("Rank1", func(b *testing.B) {
+		for i := 0; b.Loop(); i++ {
+			r.Rank1(i % r.Len())
+		}
+	})
+	b.Run("Select1", func(b *testing.B) {
+		for i := 0; b.Loop(); i++ {
+			r.Select1(i % r.Ones())
+		}
+	})
+}
@@ -0,0 +1,666 @@
+package bitset
+
+import (
+	"encoding/binary"
+	"fmt"
+	"iter"
+	"math/bits"
+	"slices"
+	"sort"
+)
+
+// Containers holding more than arrayMaxSize values are stored as bitmaps.
+// Run containers with more than runMaxSize runs are larger than a bitmap.
+const (
+	arrayMaxSize = 4096
+	bitmapWords  = 1 << 16 / 64
+	runMaxSize   = 8 * bitmapWords / 4
+)
+
+type BitSetFormatError struct {
+	Reason string
+}
+
+func (e *BitSetFormatError) Error() string {
+	return fmt.Sprintf("Invalid bitset encoding: %s", e.Reason)
+}
+
+func (e *BitSetFormatError) Is(target error) bool {
+	_, ok := target.(*BitSetFormatError)
+	return ok
+}
+
+// A container holds the low 16 bits of every value sharing the same high 16
+// bits. Mutating methods return the container that should replace the
+// receiver, which may be of a different kind.
+type container interface {
+	add(v uint16) container
+	remove(v uint16) container
+	contains(v uint16) bool
+	cardinality() int
+	all(yield func(uint16) bool) bool
+	toBitmap() *bitmapContainer
+	clone() container
+}
+
+// Sorted list of values for sparse chunks.
+type arrayContainer struct {
+	values []uint16
+}
+
+func (a *arrayContainer) add(v uint16) container {
+	i, found := slices.BinarySearch(a.values, v)
+	if found {
+		return a
+	}
+	if len(a.values) >= arrayMaxSize {
+		return a.toBitmap().add(v)
+	}
+	a.values = slices.Insert(a.values, i, v)
+	return a
+}
+
+func (a *arrayContainer) remove(v uint16) container {
+	if i, found := slices.BinarySearch(a.values, v); found {
+		a.values = slices.Delete(a.values, i, i+1)
+	}
+	return a
+}
+
+func (a *arrayContainer) contains(v uint16) bool {
+	_, found := slices.BinarySearch(a.values, v)
+	return found
+}
+
+func (a *arrayContainer) cardinality() int {
+	return len(a.values)
+}
+
+func (a *arrayContainer) all(yield func(uint16) bool) bool {
+	for _, v := range a.values {
+		if !yield(v) {
+			return false
+		}
+	}
+	return true
+}
+
+func (a *arrayContainer) toBitmap() *bitmapContainer {
+	b := &bitmapContainer{}
+	for _, v := range a.values {
+		b.words[v/64] |= 1 << (v % 64)
+	}
+	b.card = len(a.values)
+	return b
+}
+
+func (a *arrayContainer) clone() container {
+	return &arrayContainer{values: slices.Clone(a.values)}
+}
+
+// Fixed 8KiB bitmap for dense chunks.
+type bitmapContainer struct {
+	words [bitmapWords]uint64
+	card  int
+}
+
+func (b *bitmapContainer) add(v uint16) container {
+	mask := uint64(1) << (v % 64)
+	if b.words[v/64]&mask == 0 {
+		b.words[v/64] |= mask
+		b.card++
+	}
+	return b
+}
+
+func (b *bitmapContainer) remove(v uint16) container {
+	mask := uint64(1) << (v % 64)
+	if b.words[v/64]&mask != 0 {
+		b.words[v/64] &= ^mask
+		b.card--
+	}
+	if b.card <= arrayMaxSize {
+		return b.toArray()
+	}
+	return b
+}
+
+func (b *bitmapContainer) contains(v uint16) bool {
+	return b.words[v/64]&(1<<(v%64)) != 0
+}
+
+func (b *bitmapContainer) cardinality() int {
+	return b.card
+}
+
+func (b *bitmapContainer) all(yield func(uint16) bool) bool {
+	for i, w := range b.words {
+		for w != 0 {
+			if !yield(uint16(i*64 + bits.TrailingZeros64(w))) {
+				return false
+			}
+			w &= w - 1
+		}
+	}
+	return true
+}
+
+func (b *bitmapContainer) toBitmap() *bitmapContainer {
+	return b
+}
+
+func (b *bitmapContainer) toArray() *arrayContainer {
+	a := &arrayContainer{values: make([]uint16, 0, b.card)}
+	b.all(func(v uint16) bool {
+		a.values = append(a.values, v)
+		return true
+	})
+	return a
+}
+
+func (b *bitmapContainer) clone() container {
+	c := *b
+	return &c
+}
+
+// Recount the cardinality after bulk word operations and pick the smallest
+// of the array or bitmap representations. Returns nil if empty.
+func (b *bitmapContainer) normalize() container {
+	b.card = 0
+	for _, w := range b.words {
+		b.card += bits.OnesCount64(w)
+	}
+	switch {
+	case b.card == 0:
+		return nil
+	case b.card <= arrayMaxSize:
+		return b.toArray()
+	default:
+		return b
+	}
+}
+
+// Inclusive interval of consecutive values.
+type run struct {
+	start uint16
+	last  uint16
+}
+
+// Sorted, non-overlapping, non-adjacent runs for chunks with long sequences.
+type runContainer struct {
+	runs []run
+}
+
+// Index of the first run ending at or after v.
+func (r *runContainer) search(v uint16) int {
+	return sort.Search(len(r.runs), func(i int) bool {
+		return r.runs[i].last >= v
+	})
+}
+
+func (r *runContainer) add(v uint16) container {
+	i := r.search(v)
+	if i < len(r.runs) && r.runs[i].start <= v {
+		return r
+	}
+
+	joinPrev := i > 0 && int(r.runs[i-1].last)+1 == int(v)
+	joinNext := i < len(r.runs) && int(r.runs[i].start) == int(v)+1
+
+	switch {
+	case joinPrev && joinNext:
+		r.runs[i-1].last = r.runs[i].last
+		r.runs = slices.Delete(r.runs, i, i+1)
+	case joinPrev:
+		r.runs[i-1].last = v
+	case joinNext:
+		r.runs[i].start = v
+	default:
+		r.runs = slices.Insert(r.runs, i, run{start: v, last: v})
+	}
+	return r.fit()
+}
+
+func (r *runContainer) remove(v uint16) container {
+	i := r.search(v)
+	if i == len(r.runs) || r.runs[i].start > v {
+		return r
+	}
+
+	cur := r.runs[i]
+	switch {
+	case cur.start == cur.last:
+		r.runs = slices.Delete(r.runs, i, i+1)
+	case v == cur.start:
+		r.runs[i].start++
+	case v == cur.last:
+		r.runs[i].last--
+	default:
+		r.runs[i].last = v - 1
+		r.runs = slices.Insert(r.runs, i+1, run{start: v + 1, last: cur.last})
+	}
+	return r.fit()
+}
+
+// Switch to a bitmap, or an array if small enough, once scattered changes
+// have split the runs so far that a bitmap would be smaller.
+func (r *runContainer) fit() container {
+	if len(r.runs) <= runMaxSize {
+		return r
+	}
+	return r.toBitmap().normalize()
+}
+
+func (r *runContainer) contains(v uint16) bool {
+	i := r.search(v)
+	return i < len(r.runs) && r.runs[i].start <= v
+}
+
+func (r *runContainer) cardinality() int {
+	var card int
+	for _, rn := range r.runs {
+		card += int(rn.last) - int(rn.start) + 1
+	}
+	return card
+}
+
+func (r *runContainer) all(yield func(uint16) bool) bool {
+	for _, rn := range r.runs {
+		for v := int(rn.start); v <= int(rn.last); v++ {
+			if !yield(uint16(v)) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func (r *runContainer) toBitmap() *bitmapContainer {
+	b := &bitmapContainer{}
+	for _, rn := range r.runs {
+		for v := int(rn.start); v <= int(rn.last); v++ {
+			b.words[v/64] |= 1 << (v % 64)
+		}
+	}
+	b.card = r.cardinality()
+	return b
+}
+
+func (r *runContainer) clone() container {
+	return &runContainer{runs: slices.Clone(r.runs)}
+}
+
+func toRuns(c container) *runContainer {
+	r := &runContainer{}
+	c.all(func(v uint16) bool {
+		if n := len(r.runs); n > 0 && int(r.runs[n-1].last)+1 == int(v) {
+			r.runs[n-1].last = v
+		} else {
+			r.runs = append(r.runs, run{start: v, last: v})
+		}
+		return true
+	})
+	return r
+}
+
+// Approximate serialized payload size of a container in bytes.
+func containerSize(c container) int {
+	switch c := c.(type) {
+	case *arrayContainer:
+		return 2 * len(c.values)
+	case *bitmapContainer:
+		return 8 * bitmapWords
+	case *runContainer:
+		return 4 * len(c.runs)
+	}
+	return 0
+}
+
+// Roaring is a compressed bitmap for sets of uint32 values.
+//
+// Values are partitioned into chunks by their high 16 bits. Each chunk is
+// stored as a sorted array when sparse, a bitmap when dense, or a list of
+// runs after RunOptimize if that is smaller.
+type Roaring struct {
+	keys       []uint16
+	containers []container
+}
+
+func NewRoaring() *Roaring {
+	return &Roaring{}
+}
+
+func split(x uint32) (uint16, uint16) {
+	return uint16(x >> 16), uint16(x)
+}
+
+func (r *Roaring) find(key uint16) (int, bool) {
+	return slices.BinarySearch(r.keys, key)
+}
+
+func (r *Roaring) Add(x uint32) {
+	key, low := split(x)
+	i, found := r.find(key)
+	if !found {
+		r.keys = slices.Insert(r.keys, i, key)
+		r.containers = slices.Insert(r.containers, i, container(&arrayContainer{}))
+	}
+	r.containers[i] = r.containers[i].add(low)
+}
+
+func (r *Roaring) Remove(x uint32) {
+	key, low := split(x)
+	i, found := r.find(key)
+	if !found {
+		return
+	}
+	r.containers[i] = r.containers[i].remove(low)
+	if r.containers[i].cardinality() == 0 {
+		r.keys = slices.Delete(r.keys, i, i+1)
+		r.containers = slices.Delete(r.containers, i, i+1)
+	}
+}
+
+func (r *Roaring) Contains(x uint32) bool {
+	key, low := split(x)
+	i, found := r.find(key)
+	return found && r.containers[i].contains(low)
+}
+
+func (r *Roaring) Cardinality() int {
+	var card int
+	for _, c := range r.containers {
+		card += c.cardinality()
+	}
+	return card
+}
+
+func (r *Roaring) IsEmpty() bool {
+	return len(r.keys) == 0
+}
+
+// All iterates over the values in ascending order.
+func (r *Roaring) All() iter.Seq[uint32] {
+	return func(yield func(uint32) bool) {
+		for i, c := range r.containers {
+			high := uint32(r.keys[i]) << 16
+			if !c.all(func(v uint16) bool { return yield(high | uint32(v)) }) {
+				return
+			}
+		}
+	}
+}
+
+func (r *Roaring) Clone() *Roaring {
+	c := &Roaring{
+		keys:       slices.Clone(r.keys),
+		containers: make([]container, len(r.containers)),
+	}
+	for i := range r.containers {
+		c.containers[i] = r.containers[i].clone()
+	}
+	return c
+}
+
+// RunOptimize converts each container to a run container when that is the
+// most compact representation, or back again when it is not.
+func (r *Roaring) RunOptimize() {
+	for i, c := range r.containers {
+		runs := toRuns(c)
+		card := c.cardinality()
+		switch {
+		case containerSize(runs) < min(2*card, 8*bitmapWords):
+			r.containers[i] = runs
+		case card <= arrayMaxSize:
+			if _, ok := c.(*arrayContainer); !ok {
+				r.containers[i] = c.toBitmap().toArray()
+			}
+		default:
+			r.containers[i] = c.toBitmap()
+		}
+	}
+}
+
+// SizeInBytes estimates the memory used by the container payloads.
+func (r *Roaring) SizeInBytes() int {
+	size := 2 * len(r.keys)
+	for _, c := range r.containers {
+		size += containerSize(c)
+	}
+	return size
+}
+
+// Combine containers by key. onlyLeft and onlyRight choose whether chunks
+// present in just one operand are kept. both combines matching chunks and
+// returns nil if the result is empty.
+func (r *Roaring) combine(other *Roaring, onlyLeft, onlyRight bool, both func(a, b container) container) *Roaring {
+	result := &Roaring{}
+	push := func(key uint16, c container) {
+		if c != nil && c.cardinality() > 0 {
+			result.keys = append(result.keys, key)
+			result.containers = append(result.containers, c)
+		}
+	}
+
+	i, j := 0, 0
+	for i < len(r.keys) && j < len(other.keys) {
+		switch {
+		case r.keys[i] < other.keys[j]:
+			if onlyLeft {
+				push(r.keys[i], r.containers[i].clone())
+			}
+			i++
+		case r.keys[i] > other.keys[j]:
+			if onlyRight {
+				push(other.keys[j], other.containers[j].clone())
+			}
+			j++
+		default:
+			push(r.keys[i], both(r.containers[i], other.containers[j]))
+			i++
+			j++
+		}
+	}
+	for ; onlyLeft && i < len(r.keys); i++ {
+		push(r.keys[i], r.containers[i].clone())
+	}
+	for ; onlyRight && j < len(other.keys); j++ {
+		push(other.keys[j], other.containers[j].clone())
+	}
+
+	return result
+}
+
+func filterArray(a *arrayContainer, keep func(uint16) bool) container {
+	result := &arrayContainer{}
+	for _, v := range a.values {
+		if keep(v) {
+			result.values = append(result.values, v)
+		}
+	}
+	return result
+}
+
+func bitmapOp(a, b container, op func(x, y uint64) uint64) container {
+	x := a.toBitmap()
+	y := b.toBitmap()
+	result := &bitmapContainer{}
+	for k := range result.words {
+		result.words[k] = op(x.words[k], y.words[k])
+	}
+	return result.normalize()
+}
+
+// And returns the intersection of both sets.
+func (r *Roaring) And(other *Roaring) *Roaring {
+	return r.combine(other, false, false, func(a, b container) container {
+		if aa, ok := a.(*arrayContainer); ok {
+			return filterArray(aa, b.contains)
+		}
+		if ba, ok := b.(*arrayContainer); ok {
+			return filterArray(ba, a.contains)
+		}
+		return bitmapOp(a, b, func(x, y uint64) uint64 { return x & y })
+	})
+}
+
+// Or returns the union of both sets.
+func (r *Roaring) Or(other *Roaring) *Roaring {
+	return r.combine(other, true, true, func(a, b container) container {
+		return bitmapOp(a, b, func(x, y uint64) uint64 { return x | y })
+	})
+}
+
+// AndNot returns the values in r which are not in other.
+func (r *Roaring) AndNot(other *Roaring) *Roaring {
+	return r.combine(other, true, false, func(a, b container) container {
+		if aa, ok := a.(*arrayContainer); ok {
+			return filterArray(aa, func(v uint16) bool { return !b.contains(v) })
+		}
+		return bitmapOp(a, b, func(x, y uint64) uint64 { return x &^ y })
+	})
+}
+
+// Xor returns the values in exactly one of the two sets.
+func (r *Roaring) Xor(other *Roaring) *Roaring {
+	return r.combine(other, true, true, func(a, b container) container {
+		return bitmapOp(a, b, func(x, y uint64) uint64 { return x ^ y })
+	})
+}
+
+// Container kinds in the serialized format.
+const (
+	kindArray byte = iota
+	kindBitmap
+	kindRun
+)
+
+var roaringMagic = [4]byte{'R', 'B', 'M', '1'}
+
+// MarshalBinary encodes the bitmap as little-endian bytes:
+//
+//	magic "RBM1" | uint32 container count
+//	per container: uint16 key | uint8 kind | uint32 length | payload
+//
+// The payload is length uint16 values for arrays, 1024 uint64 words for
+// bitmaps (length is the cardinality) and length (start, last) uint16 pairs
+// for runs.
+func (r *Roaring) MarshalBinary() ([]byte, error) {
+	buf := make([]byte, 0, 8+7*len(r.keys)+r.SizeInBytes())
+	buf = append(buf, roaringMagic[:]...)
+	buf = binary.LittleEndian.AppendUint32(buf, uint32(len(r.keys)))
+
+	for i, c := range r.containers {
+		buf = binary.LittleEndian.AppendUint16(buf, r.keys[i])
+		switch c := c.(type) {
+		case *arrayContainer:
+			buf = append(buf, kindArray)
+			buf = binary.LittleEndian.AppendUint32(buf, uint32(len(c.values)))
+			for _, v := range c.values {
+				buf = binary.LittleEndian.AppendUint16(buf, v)
+			}
+		case *bitmapContainer:
+			buf = append(buf, kindBitmap)
+			buf = binary.LittleEndian.AppendUint32(buf, uint32(c.card))
+			for _, w := range c.words {
+				buf = binary.LittleEndian.AppendUint64(buf, w)
+			}
+		case *runContainer:
+			buf = append(buf, kindRun)
+			buf = binary.LittleEndian.AppendUint32(buf, uint32(len(c.runs)))
+			for _, rn := range c.runs {
+				buf = binary.LittleEndian.AppendUint16(buf, rn.start)
+				buf = binary.LittleEndian.AppendUint16(buf, rn.last)
+			}
+		}
+	}
+
+	return buf, nil
+}
+
+// UnmarshalBinary decodes the format written by MarshalBinary, replacing the
+// current contents.
+func (r *Roaring) UnmarshalBinary(data []byte) error {
+	if len(data) < 8 || [4]byte(data[:4]) != roaringMagic {
+		return &BitSetFormatError{"missing roaring header"}
+	}
+	count := int(binary.LittleEndian.Uint32(data[4:]))
+	data = data[8:]
+
+	keys := make([]uint16, 0, min(count, len(data)/7))
+	containers := make([]container, 0, cap(keys))
+
+	for range count {
+		if len(data) < 7 {
+			return &BitSetFormatError{"truncated container header"}
+		}
+		key := binary.LittleEndian.Uint16(data)
+		kind := data[2]
+		length := int(binary.LittleEndian.Uint32(data[3:]))
+		data = data[7:]
+
+		if n := len(keys); n > 0 && keys[n-1] >= key {
+			return &BitSetFormatError{"container keys out of order"}
+		}
+
+		var c container
+		switch kind {
+		case kindArray:
+			if length == 0 || length > arrayMaxSize || len(data) < 2*length {
+				return &BitSetFormatError{"bad array container"}
+			}
+			a := &arrayContainer{values: make([]uint16, length)}
+			for k := range a.values {
+				a.values[k] = binary.LittleEndian.Uint16(data[2*k:])
+				if k > 0 && a.values[k-1] >= a.values[k] {
+					return &BitSetFormatError{"array values out of order"}
+				}
+			}
+			data = data[2*length:]
+			c = a
+		case kindBitmap:
+			if len(data) < 8*bitmapWords {
+				return &BitSetFormatError{"bad bitmap container"}
+			}
+			b := &bitmapContainer{}
+			for k := range b.words {
+				b.words[k] = binary.LittleEndian.Uint64(data[8*k:])
+				b.card += bits.OnesCount64(b.words[k])
+			}
+			if b.card != length || b.card == 0 {
+				return &BitSetFormatError{"bitmap cardinality mismatch"}
+			}
+			data = data[8*bitmapWords:]
+			c = b
+		case kindRun:
+			if length == 0 || len(data) < 4*length {
+				return &BitSetFormatError{"bad run container"}
+			}
+			rc := &runContainer{runs: make([]run, length)}
+			for k := range rc.runs {
+				rc.runs[k].start = binary.LittleEndian.Uint16(data[4*k:])
+				rc.runs[k].last = binary.LittleEndian.Uint16(data[4*k+2:])
+				if rc.runs[k].start > rc.runs[k].last ||
+					(k > 0 && int(rc.runs[k-1].last)+1 >= int(rc.runs[k].start)) {
+					return &BitSetFormatError{"runs overlap or out of order"}
+				}
+			}
+			data = data[4*length:]
+			c = rc
+		default:
+			return &BitSetFormatError{fmt.Sprintf("unknown container kind %d", kind)}
+		}
+
+		keys = append(keys, key)
+		containers = append(containers, c)
+	}
+
+	if len(data) != 0 {
+		return &BitSetFormatError{"trailing bytes"}
+	}
+
+	r.keys = keys
+	r.containers = containers
+
+	return nil
+}
@@ -0,0 +1,371 @@
+package bitset_test
+
+import (
+	"encoding/json"
+	"errors"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/jdavasligil/golang-dsa/bitset"
+)
+
+func roaringFrom(values ...uint32) *bitset.Roaring {
+	r := bitset.NewRoaring()
+	for _, v := range values {
+		r.Add(v)
+	}
+	return r
+}
+
+func sortedKeys(m map[uint32]bool) []uint32 {
+	keys := make([]uint32, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+func checkRoaring(t *testing.T, r *bitset.Roaring, model map[uint32]bool) {
+	t.Helper()
+
+	if r.Cardinality() != len(model) {
+		t.Fatalf("Cardinality %d != expected %d", r.Cardinality(), len(model))
+	}
+
+	got := slices.Collect(r.All())
+	if expected := sortedKeys(model); !slices.Equal(got, expected) {
+		t.Fatalf("Iteration mismatch. Got %d values, expected %d", len(got), len(expected))
+	}
+}
+
+// Values clustered into a few chunks so that every container kind is exercised.
+func randomValues(rng *rand.Rand, n int) []uint32 {
+	values := make([]uint32, n)
+	for i := range values {
+		chunk := uint32(rng.IntN(4)) << 16
+		switch rng.IntN(3) {
+		case 0:
+			values[i] = chunk | uint32(rng.IntN(1<<16))
+		case 1:
+			values[i] = chunk | uint32(rng.IntN(512))
+		default:
+			values[i] = rng.Uint32()
+		}
+	}
+	return values
+}
+
+func TestRoaring(t *testing.T) {
+	t.Run("AddRemoveContains", func(t *testing.T) {
+		r := bitset.NewRoaring()
+
+		tests := []struct {
+			value    uint32
+			expected bool
+		}{
+			{value: 0, expected: true},
+			{value: 65535, expected: true},
+			{value: 65536, expected: true},
+			{value: 1<<32 - 1, expected: true},
+		}
+
+		for i, test := range tests {
+			r.Add(test.value)
+			if r.Contains(test.value) != test.expected {
+				t.Errorf("Test %d failed. Expected %v - Got %v", i, test.expected, r.Contains(test.value))
+			}
+		}
+
+		if r.Contains(12345) {
+			t.Error("Contains reported a value never added")
+		}
+
+		for _, test := range tests {
+			r.Remove(test.value)
+		}
+		if !r.IsEmpty() {
+			t.Errorf("Expected empty bitmap - Got cardinality %d", r.Cardinality())
+		}
+	})
+
+	t.Run("Model", func(t *testing.T) {
+		rng := rand.New(rand.NewPCG(1, 2))
+		r := bitset.NewRoaring()
+		model := make(map[uint32]bool)
+
+		for round := range 4 {
+			for _, v := range randomValues(rng, 20000) {
+				r.Add(v)
+				model[v] = true
+			}
+			checkRoaring(t, r, model)
+
+			for _, v := range randomValues(rng, 20000) {
+				r.Remove(v)
+				delete(model, v)
+			}
+			checkRoaring(t, r, model)
+
+			if round%2 == 1 {
+				r.RunOptimize()
+				checkRoaring(t, r, model)
+			}
+		}
+	})
+
+	t.Run("Runs", func(t *testing.T) {
+		r := bitset.NewRoaring()
+		model := make(map[uint32]bool)
+		for v := uint32(100); v < 70000; v++ {
+			r.Add(v)
+			model[v] = true
+		}
+
+		before := r.SizeInBytes()
+		r.RunOptimize()
+		if r.SizeInBytes() >= before {
+			t.Errorf("RunOptimize did not shrink: %d >= %d", r.SizeInBytes(), before)
+		}
+		checkRoaring(t, r, model)
+
+		for _, v := range []uint32{99, 100, 101, 500, 65535, 65536, 69999, 70000} {
+			r.Remove(v)
+			delete(model, v)
+			checkRoaring(t, r, model)
+		}
+		for _, v := range []uint32{100, 500, 501, 65535, 65536, 70000} {
+			r.Add(v)
+			model[v] = true
+			checkRoaring(t, r, model)
+		}
+	})
+
+	// Scattered changes after RunOptimize must not leave a run container
+	// larger than a bitmap.
+	t.Run("RunsFragment", func(t *testing.T) {
+		const bitmapBytes = 2 + 8*1024
+
+		r := bitset.NewRoaring()
+		model := make(map[uint32]bool)
+		for v := uint32(0); v < 10; v++ {
+			r.Add(v)
+			model[v] = true
+		}
+		r.RunOptimize()
+		for v := uint32(20); v < 1<<16; v += 2 {
+			r.Add(v)
+			model[v] = true
+		}
+		if size := r.SizeInBytes(); size > bitmapBytes {
+			t.Errorf("Alternating adds grew the container to %d bytes", size)
+		}
+		checkRoaring(t, r, model)
+
+		r = bitset.NewRoaring()
+		model = make(map[uint32]bool)
+		for v := uint32(0); v < 1<<16; v++ {
+			r.Add(v)
+			model[v] = true
+		}
+		r.RunOptimize()
+		for v := uint32(0); v < 1<<16; v += 2 {
+			r.Remove(v)
+			delete(model, v)
+		}
+		if size := r.SizeInBytes(); size > bitmapBytes {
+			t.Errorf("Alternating removes grew the container to %d bytes", size)
+		}
+		checkRoaring(t, r, model)
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		r := roaringFrom(70000, 5, 1)
+		if s := r.String(); s != "{1, 5, 70000}" {
+			t.Errorf("String %q != expected {1, 5, 70000}", s)
+		}
+		if s := bitset.NewRoaring().String(); s != "{}" {
+			t.Errorf("String %q != expected {}", s)
+		}
+
+		data, err := json.Marshal(r)
+		if err != nil || string(data) != `[1,5,70000]` {
+			t.Errorf("Expected [1,5,70000] - Got %s (%v)", data, err)
+		}
+
+		rng := rand.New(rand.NewPCG(9, 10))
+		model := make(map[uint32]bool)
+		r = bitset.NewRoaring()
+		for _, v := range randomValues(rng, 10000) {
+			r.Add(v)
+			model[v] = true
+		}
+		r.RunOptimize()
+		data, err = json.Marshal(r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		decoded := roaringFrom(1, 2, 3)
+		if err := json.Unmarshal(data, decoded); err != nil {
+			t.Fatal(err)
+		}
+		checkRoaring(t, decoded, model)
+
+		for _, bad := range []string{`[-1]`, `[4294967296]`, `"0x1"`, `{}`} {
+			if err := json.Unmarshal([]byte(bad), decoded); !errors.Is(err, &bitset.BitSetFormatError{}) {
+				t.Errorf("%s Got: %v  Expected: BitSetFormatError.", bad, err)
+			}
+		}
+	})
+
+	t.Run("SetOperations", func(t *testing.T) {
+		rng := rand.New(rand.NewPCG(3, 4))
+
+		for range 10 {
+			av := randomValues(rng, 10000)
+			bv := randomValues(rng, 10000)
+			a := roaringFrom(av...)
+			b := roaringFrom(bv...)
+			if rng.IntN(2) == 0 {
+				a.RunOptimize()
+			}
+
+			inA := make(map[uint32]bool)
+			inB := make(map[uint32]bool)
+			for _, v := range av {
+				inA[v] = true
+			}
+			for _, v := range bv {
+				inB[v] = true
+			}
+
+			and := make(map[uint32]bool)
+			or := make(map[uint32]bool)
+			andNot := make(map[uint32]bool)
+			xor := make(map[uint32]bool)
+			for v := range inA {
+				or[v] = true
+				if inB[v] {
+					and[v] = true
+				} else {
+					andNot[v] = true
+					xor[v] = true
+				}
+			}
+			for v := range inB {
+				or[v] = true
+				if !inA[v] {
+					xor[v] = true
+				}
+			}
+
+			checkRoaring(t, a.And(b), and)
+			checkRoaring(t, a.Or(b), or)
+			checkRoaring(t, a.AndNot(b), andNot)
+			checkRoaring(t, a.Xor(b), xor)
+			checkRoaring(t, a, inA)
+		}
+	})
+
+	t.Run("MarshalBinary", func(t *testing.T) {
+		rng := rand.New(rand.NewPCG(5, 6))
+		r := roaringFrom(randomValues(rng, 50000)...)
+		for v := uint32(200000); v < 210000; v++ {
+			r.Add(v)
+		}
+		r.RunOptimize()
+
+		data, err := r.MarshalBinary()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		decoded := bitset.NewRoaring()
+		if err := decoded.UnmarshalBinary(data); err != nil {
+			t.Fatal(err)
+		}
+		if !slices.Equal(slices.Collect(decoded.All()), slices.Collect(r.All())) {
+			t.Error("Round trip changed contents")
+		}
+
+		bad := [][]byte{
+			nil,
+			[]byte("XXXX\x00\x00\x00\x00"),
+			data[:len(data)-1],
+			append(slices.Clone(data), 0),
+		}
+		for i, b := range bad {
+			if err := decoded.UnmarshalBinary(b); !errors.Is(err, &bitset.BitSetFormatError{}) {
+				t.Errorf("Test %d: Got %v  Expected: BitSetFormatError.", i, err)
+			}
+		}
+	})
+}
+
+func sparseValues(n int) []uint32 {
+	rng := rand.New(rand.NewPCG(7, 8))
+	values := make([]uint32, n)
+	for i := range values {
+		values[i] = rng.Uint32() >> 4
+	}
+	return values
+}
+
+func BenchmarkRoaringAdd(b *testing.B) {
+	values := sparseValues(100000)
+	b.ReportAllocs()
+
+	for b.Loop() {
+		r := bitset.NewRoaring()
+		for _, v := range values {
+			r.Add(v)
+		}
+		b.ReportMetric(float64(r.SizeInBytes()), "bytes/set")
+	}
+}
+
+func BenchmarkBitSetAdd(b *testing.B) {
+	values := sparseValues(100000)
+	b.ReportAllocs()
+
+	for b.Loop() {
+		d := bitset.NewBitSet(0)
+		for _, v := range values {
+			d.Set(int(v))
+		}
+		b.ReportMetric(float64(d.Len()/8), "bytes/set")
+	}
+}
+
+func BenchmarkRoaringContains(b *testing.B) {
+	values := sparseValues(100000)
+	r := roaringFrom(values...)
+	b.ResetTimer()
+
+	for i := 0; b.Loop(); i++ {
+		r.Contains(values[i%len(values)])
+	}
+}
+
+func BenchmarkBitSetContains(b *testing.B) {
+	values := sparseValues(100000)
+	d := bitset.NewBitSet(0)
+	for _, v := range values {
+		d.Set(int(v))
+	}
+	b.ResetTimer()
+
+	for i := 0; b.Loop(); i++ {
+		d.Get(int(values[i%len(values)]))
+	}
+}
+
+func BenchmarkRoaringAnd(b *testing.B) {
+	x := roaringFrom(sparseValues(100000)...)
+	y := roaringFrom(sparseValues(50000)...)
+
+	for b.Loop() {
+		x.And(y)
+	}
+}
@@ -0,0 +1,228 @@
+// BloomFilter
+// Probabilistic set membership using a bitset
+
+package bloomfilter
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/maphash"
+	"math"
+	"math/bits"
+
+	"github.com/jdavasligil/golang-dsa/bitset"
+)
+
+type BloomConstraintError struct {
+	Constraint string
+}
+
+func (e *BloomConstraintError) Error() string {
+	return fmt.Sprintf("Constraint violated: %s", e.Constraint)
+}
+
+func (e *BloomConstraintError) Is(target error) bool {
+	_, ok := target.(*BloomConstraintError)
+	return ok
+}
+
+type BloomFormatError struct {
+	Reason string
+}
+
+func (e *BloomFormatError) Error() string {
+	return fmt.Sprintf("Invalid bloom filter encoding: %s", e.Reason)
+}
+
+func (e *BloomFormatError) Is(target error) bool {
+	_, ok := target.(*BloomFormatError)
+	return ok
+}
+
+// Seed shared by every filter using the default hash, so filters in the same
+// process can be combined. It changes between processes.
+var defaultSeed = maphash.MakeSeed()
+
+// DefaultHash hashes any comparable value with hash/maphash.
+//
+// The seed is random per process, so filters using it must not be persisted
+// and loaded by another process. Supply a deterministic hash for that.
+func DefaultHash[T comparable](item T) uint64 {
+	return maphash.Comparable(defaultSeed, item)
+}
+
+// Most hash functions per item. Only false positive rates below about 1e-19
+// would call for more, and decoding an untrusted filter must not be able to
+// demand billions of hashes per lookup.
+const maxHashCount = 64
+
+// Optimal number of bits and hash functions for n items at false positive
+// rate p. The hash count is capped at maxHashCount.
+func optimalSize(n int, p float64) (int, int, error) {
+	if n <= 0 {
+		return 0, 0, &BloomConstraintError{fmt.Sprintf("Expected Items %d > 0", n)}
+	}
+	if p <= 0 || p >= 1 {
+		return 0, 0, &BloomConstraintError{fmt.Sprintf("0 < False Positive Rate %.4f < 1", p)}
+	}
+
+	m := int(math.Ceil(-float64(n) * math.Log(p) / (math.Ln2 * math.Ln2)))
+	k := min(maxHashCount, max(1, int(math.Round(float64(m)/float64(n)*math.Ln2))))
+
+	return m, k, nil
+}
+
+// Kirsch-Mitzenmacher double hashing: k indices from one 64 bit hash.
+func locations(h uint64, k, m int, yield func(idx int) bool) {
+	h1 := h
+	h2 := bits.RotateLeft64(h, 32) | 1
+	for i := range k {
+		if !yield(int((h1 + uint64(i)*h2) % uint64(m))) {
+			return
+		}
+	}
+}
+
+// Estimate the number of distinct items from the number of set bits.
+func estimate(setBits, k, m int) float64 {
+	if setBits >= m {
+		return math.Inf(1)
+	}
+	return -float64(m) / float64(k) * math.Log(1-float64(setBits)/float64(m))
+}
+
+type BloomFilter[T any] struct {
+	bits *bitset.BitSet
+	m    int
+	k    int
+	hash func(T) uint64
+}
+
+// NewBloomFilter sizes a filter to hold expectedItems at the requested false
+// positive rate using DefaultHash.
+func NewBloomFilter[T comparable](expectedItems int, falsePositiveRate float64) (*BloomFilter[T], error) {
+	return NewBloomFilterWithHash(expectedItems, falsePositiveRate, DefaultHash[T])
+}
+
+func NewBloomFilterWithHash[T any](expectedItems int, falsePositiveRate float64, hash func(T) uint64) (*BloomFilter[T], error) {
+	m, k, err := optimalSize(expectedItems, falsePositiveRate)
+	if err != nil {
+		return nil, err
+	}
+
+	return &BloomFilter[T]{
+		bits: bitset.NewBitSet(m),
+		m:    m,
+		k:    k,
+		hash: hash,
+	}, nil
+}
+
+// Bits returns the size of the filter in bits.
+func (f *BloomFilter[T]) Bits() int {
+	return f.m
+}
+
+// HashCount returns the number of bits set per item.
+func (f *BloomFilter[T]) HashCount() int {
+	return f.k
+}
+
+func (f *BloomFilter[T]) Add(item T) {
+	locations(f.hash(item), f.k, f.m, func(idx int) bool {
+		f.bits.Set(idx)
+		return true
+	})
+}
+
+// Contains returns false if the item was definitely never added, and true if
+// it probably was.
+func (f *BloomFilter[T]) Contains(item T) bool {
+	found := true
+	locations(f.hash(item), f.k, f.m, func(idx int) bool {
+		found = f.bits.Get(idx)
+		return found
+	})
+	return found
+}
+
+// Union adds every item from other. Both filters must have the same size,
+// hash count and hash function.
+func (f *BloomFilter[T]) Union(other *BloomFilter[T]) error {
+	if f.m != other.m || f.k != other.k {
+		return &BloomConstraintError{"Union requires filters of equal size and hash count"}
+	}
+	f.bits.Or(other.bits)
+	return nil
+}
+
+// EstimatedCardinality approximates the number of distinct items added.
+func (f *BloomFilter[T]) EstimatedCardinality() float64 {
+	return estimate(f.bits.Count(), f.k, f.m)
+}
+
+func (f *BloomFilter[T]) Clear() {
+	f.bits = bitset.NewBitSet(f.m)
+}
+
+var bloomMagic = [4]byte{'B', 'L', 'M', '1'}
+
+// MarshalBinary writes the magic "BLM1", the bit count m and hash count k as
+// little-endian uint64 and uint32, then the bitset words. The hash function
+// is not included.
+func (f *BloomFilter[T]) MarshalBinary() ([]byte, error) {
+	words, err := f.bits.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+
+	buf := appendHeader(make([]byte, 0, 16+len(words)), bloomMagic, f.m, f.k)
+
+	return append(buf, words...), nil
+}
+
+// UnmarshalBinary replaces the size and contents of the filter, keeping its
+// hash function.
+func (f *BloomFilter[T]) UnmarshalBinary(data []byte) error {
+	m, k, data, err := decodeHeader(data, bloomMagic)
+	if err != nil {
+		return err
+	}
+	if len(data) != (m+63)/64*8 {
+		return &BloomFormatError{"bitset length does not match size"}
+	}
+	if rem := m % 64; rem != 0 && binary.LittleEndian.Uint64(data[len(data)-8:])>>rem != 0 {
+		return &BloomFormatError{"bits set beyond size"}
+	}
+
+	b := bitset.NewBitSet(0)
+	if err := b.UnmarshalBinary(data); err != nil {
+		return &BloomFormatError{err.Error()}
+	}
+
+	f.bits = b
+	f.m = m
+	f.k = k
+
+	return nil
+}
+
+func appendHeader(buf []byte, magic [4]byte, m, k int) []byte {
+	buf = append(buf, magic[:]...)
+	buf = binary.LittleEndian.AppendUint64(buf, uint64(m))
+	return binary.LittleEndian.AppendUint32(buf, uint32(k))
+}
+
+func decodeHeader(data []byte, magic [4]byte) (int, int, []byte, error) {
+	if len(data) < 16 || [4]byte(data[:4]) != magic {
+		return 0, 0, nil, &BloomFormatError{"missing header"}
+	}
+
+	m := binary.LittleEndian.Uint64(data[4:])
+	k := binary.LittleEndian.Uint32(data[12:])
+	if m == 0 || m > math.MaxInt32 || k == 0 || k > maxHashCount {
+		return 0, 0, nil, &BloomFormatError{"invalid size or hash count"}
+	}
+
+	return int(m), int(k), data[16:], nil
+}
@@ -0,0 +1,156 @@
+package bloomfilter
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"math"
+	"slices"
+	"testing"
+)
+
+func fnvHash(s string) uint64 {
+	h := fnv.New64a()
+	h.Write([]byte(s))
+	return h.Sum64()
+}
+
+func TestBloomFilter(t *testing.T) {
+	t.Run("New", func(t *testing.T) {
+		f, err := NewBloomFilter[string](1000, 0.01)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if f.Bits() != 9586 || f.HashCount() != 7 {
+			t.Errorf("Got m=%d k=%d  Expected m=9586 k=7", f.Bits(), f.HashCount())
+		}
+
+		if _, err := NewBloomFilter[string](0, 0.01); !errors.Is(err, &BloomConstraintError{}) {
+			t.Errorf("Got: %v  Expected: BloomConstraintError.", err)
+		}
+		if _, err := NewBloomFilter[string](10, 1); !errors.Is(err, &BloomConstraintError{}) {
+			t.Errorf("Got: %v  Expected: BloomConstraintError.", err)
+		}
+	})
+
+	t.Run("FalsePositiveRate", func(t *testing.T) {
+		const n = 10000
+		const p = 0.01
+		f, _ := NewBloomFilter[string](n, p)
+
+		for i := range n {
+			f.Add(fmt.Sprintf("msg-%d", i))
+		}
+		for i := range n {
+			if !f.Contains(fmt.Sprintf("msg-%d", i)) {
+				t.Fatalf("False negative for msg-%d", i)
+			}
+		}
+
+		var falsePositives int
+		for i := range n {
+			if f.Contains(fmt.Sprintf("other-%d", i)) {
+				falsePositives++
+			}
+		}
+		if rate := float64(falsePositives) / n; rate > 2*p {
+			t.Errorf("False positive rate %.4f exceeds twice target %.4f", rate, p)
+		}
+
+		if est := f.EstimatedCardinality(); math.Abs(est-n)/n > 0.05 {
+			t.Errorf("Estimated cardinality %.0f too far from %d", est, n)
+		}
+	})
+
+	t.Run("Union", func(t *testing.T) {
+		a, _ := NewBloomFilter[int](100, 0.01)
+		b, _ := NewBloomFilter[int](100, 0.01)
+		a.Add(1)
+		b.Add(2)
+
+		if err := a.Union(b); err != nil {
+			t.Fatal(err)
+		}
+		if !a.Contains(1) || !a.Contains(2) {
+			t.Error("Union lost an item")
+		}
+
+		c, _ := NewBloomFilter[int](1000, 0.01)
+		if err := a.Union(c); !errors.Is(err, &BloomConstraintError{}) {
+			t.Errorf("Got: %v  Expected: BloomConstraintError.", err)
+		}
+	})
+
+	t.Run("MarshalBinary", func(t *testing.T) {
+		f, _ := NewBloomFilterWithHash(500, 0.05, fnvHash)
+		for i := range 500 {
+			f.Add(fmt.Sprint(i))
+		}
+
+		data, err := f.MarshalBinary()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		decoded, _ := NewBloomFilterWithHash(1, 0.5, fnvHash)
+		if err := decoded.UnmarshalBinary(data); err != nil {
+			t.Fatal(err)
+		}
+		if decoded.Bits() != f.Bits() || decoded.HashCount() != f.HashCount() {
+			t.Errorf("Round trip changed size")
+		}
+		for i := range 500 {
+			if !decoded.Contains(fmt.Sprint(i)) {
+				t.Fatalf("Round trip lost %d", i)
+			}
+		}
+
+		if err := decoded.UnmarshalBinary(data[:len(data)-1]); !errors.Is(err, &BloomFormatError{}) {
+			t.Errorf("Got: %v  Expected: BloomFormatError.", err)
+		}
+		if err := decoded.UnmarshalBinary([]byte("nope")); !errors.Is(err, &BloomFormatError{}) {
+			t.Errorf("Got: %v  Expected: BloomFormatError.", err)
+		}
+
+		malformed := map[string]func(data []byte){
+			"ZeroHashCount": func(data []byte) { binary.LittleEndian.PutUint32(data[12:], 0) },
+			"HugeHashCount": func(data []byte) { binary.LittleEndian.PutUint32(data[12:], math.MaxUint32) },
+			"Padding":       func(data []byte) { data[len(data)-1] |= 0x80 },
+		}
+		for name, corrupt := range malformed {
+			bad := slices.Clone(data)
+			corrupt(bad)
+			if err := decoded.UnmarshalBinary(bad); !errors.Is(err, &BloomFormatError{}) {
+				t.Errorf("%s Got: %v  Expected: BloomFormatError.", name, err)
+			}
+		}
+	})
+
+	t.Run("HashCountCap", func(t *testing.T) {
+		f, err := NewBloomFilter[int](10, 1e-300)
+		if err != nil || f.HashCount() != maxHashCount {
+			t.Errorf("HashCount %d != expected %d (%v)", f.HashCount(), maxHashCount, err)
+		}
+	})
+}
+
+func BenchmarkBloomFilterAdd(b *testing.B) {
+	f, _ := NewBloomFilter[int](1_000_000, 0.01)
+
+	for i := 0; b.Loop(); i++ {
+		f.Add(i)
+	}
+}
+
+func BenchmarkBloomFilterContains(b *testing.B) {
+	f, _ := NewBloomFilter[int](1_000_000, 0.01)
+	for i := range 1_000_000 {
+		f.Add(i)
+	}
+	b.ResetTimer()
+
+	for i := 0; b.Loop(); i++ {
+		f.Contains(i)
+	}
+}
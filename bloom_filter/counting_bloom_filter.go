@@ -0,0 +1,123 @@
+package bloomfilter
+
+import (
+	"math"
+	"slices"
+)
+
+var countingMagic = [4]byte{'C', 'B', 'F', '1'}
+
+// CountingBloomFilter replaces each bit with an 8 bit counter so items can be
+// removed. Counters saturate at 255 and are never decremented afterwards,
+// which keeps false negatives impossible.
+type CountingBloomFilter[T any] struct {
+	counters []uint8
+	k        int
+	hash     func(T) uint64
+}
+
+func NewCountingBloomFilter[T comparable](expectedItems int, falsePositiveRate float64) (*CountingBloomFilter[T], error) {
+	return NewCountingBloomFilterWithHash(expectedItems, falsePositiveRate, DefaultHash[T])
+}
+
+func NewCountingBloomFilterWithHash[T any](expectedItems int, falsePositiveRate float64, hash func(T) uint64) (*CountingBloomFilter[T], error) {
+	m, k, err := optimalSize(expectedItems, falsePositiveRate)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CountingBloomFilter[T]{
+		counters: make([]uint8, m),
+		k:        k,
+		hash:     hash,
+	}, nil
+}
+
+// Bits returns the number of counters.
+func (f *CountingBloomFilter[T]) Bits() int {
+	return len(f.counters)
+}
+
+// HashCount returns the number of counters incremented per item.
+func (f *CountingBloomFilter[T]) HashCount() int {
+	return f.k
+}
+
+func (f *CountingBloomFilter[T]) Add(item T) {
+	locations(f.hash(item), f.k, len(f.counters), func(idx int) bool {
+		if f.counters[idx] < math.MaxUint8 {
+			f.counters[idx]++
+		}
+		return true
+	})
+}
+
+// Contains returns false if the item is definitely absent, and true if it
+// probably is present.
+func (f *CountingBloomFilter[T]) Contains(item T) bool {
+	found := true
+	locations(f.hash(item), f.k, len(f.counters), func(idx int) bool {
+		found = f.counters[idx] > 0
+		return found
+	})
+	return found
+}
+
+// Remove decrements the counters for item. Returns false and leaves the
+// filter unchanged if the item is definitely absent.
+//
+// Removing an item which was never added may remove other items.
+func (f *CountingBloomFilter[T]) Remove(item T) bool {
+	if !f.Contains(item) {
+		return false
+	}
+
+	locations(f.hash(item), f.k, len(f.counters), func(idx int) bool {
+		if f.counters[idx] < math.MaxUint8 {
+			f.counters[idx]--
+		}
+		return true
+	})
+
+	return true
+}
+
+// EstimatedCardinality approximates the number of distinct items present.
+func (f *CountingBloomFilter[T]) EstimatedCardinality() float64 {
+	var nonzero int
+	for _, c := range f.counters {
+		if c > 0 {
+			nonzero++
+		}
+	}
+	return estimate(nonzero, f.k, len(f.counters))
+}
+
+func (f *CountingBloomFilter[T]) Clear() {
+	clear(f.counters)
+}
+
+// MarshalBinary uses the same header as BloomFilter with the magic "CBF1",
+// followed by one byte per counter.
+func (f *CountingBloomFilter[T]) MarshalBinary() ([]byte, error) {
+	buf := appendHeader(make([]byte, 0, 16+len(f.counters)), countingMagic, len(f.counters), f.k)
+
+	return append(buf, f.counters...), nil
+}
+
+// UnmarshalBinary replaces the size and contents of the filter, keeping its
+// hash function.
+func (f *CountingBloomFilter[T]) UnmarshalBinary(data []byte) error {
+	m, k, data, err := decodeHeader(data, countingMagic)
+	if err != nil {
+		return err
+	}
+	if len(data) != m {
+		return &BloomFormatError{"counter length does not match size"}
+	}
+
+	f.counters = slices.Clone(data)
+	f.k = k
+
+	return nil
+}
@@ -0,0 +1,90 @@
+package bloomfilter
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"testing"
+)
+
+func TestCountingBloomFilter(t *testing.T) {
+	t.Run("AddRemove", func(t *testing.T) {
+		f, err := NewCountingBloomFilter[string](1000, 0.01)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for i := range 1000 {
+			f.Add(fmt.Sprint(i))
+		}
+		for i := range 500 {
+			if !f.Remove(fmt.Sprint(i)) {
+				t.Fatalf("Remove of present item %d failed", i)
+			}
+		}
+
+		for i := 500; i < 1000; i++ {
+			if !f.Contains(fmt.Sprint(i)) {
+				t.Fatalf("False negative for %d after removals", i)
+			}
+		}
+
+		var stillPresent int
+		for i := range 500 {
+			if f.Contains(fmt.Sprint(i)) {
+				stillPresent++
+			}
+		}
+		if stillPresent > 25 {
+			t.Errorf("%d of 500 removed items still reported present", stillPresent)
+		}
+	})
+
+	t.Run("RemoveAbsent", func(t *testing.T) {
+		f, _ := NewCountingBloomFilter[int](100, 0.01)
+		if f.Remove(42) {
+			t.Error("Remove of absent item from empty filter should fail")
+		}
+	})
+
+	t.Run("Saturation", func(t *testing.T) {
+		f, _ := NewCountingBloomFilter[int](10, 0.1)
+		for range 300 {
+			f.Add(7)
+		}
+		for range 300 {
+			f.Remove(7)
+		}
+		if !f.Contains(7) {
+			t.Error("Saturated counters must not be decremented")
+		}
+	})
+
+	t.Run("MarshalBinary", func(t *testing.T) {
+		f, _ := NewCountingBloomFilterWithHash(100, 0.01, fnvHash)
+		f.Add("a")
+		f.Add("b")
+
+		data, _ := f.MarshalBinary()
+		decoded, _ := NewCountingBloomFilterWithHash(1, 0.5, fnvHash)
+		if err := decoded.UnmarshalBinary(data); err != nil {
+			t.Fatal(err)
+		}
+		if !decoded.Contains("a") || !decoded.Remove("b") || decoded.Contains("b") {
+			t.Error("Round trip changed contents")
+		}
+
+		var plain BloomFilter[string]
+		if err := plain.UnmarshalBinary(data); !errors.Is(err, &BloomFormatError{}) {
+			t.Errorf("Got: %v  Expected: BloomFormatError.", err)
+		}
+
+		huge := slices.Clone(data)
+		binary.LittleEndian.PutUint32(huge[12:], math.MaxUint32)
+		if err := decoded.UnmarshalBinary(huge); !errors.Is(err, &BloomFormatError{}) {
+			t.Errorf("Got: %v  Expected: BloomFormatError.", err)
+		}
+	})
+}
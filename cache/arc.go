@@ -0,0 +1,205 @@
+package cache
+
+import (
+	"time"
+
+	dll "github.com/jdavasligil/golang-dsa/doubly_linked_list"
+)
+
+var _ Cache[int, int] = (*ARC[int, int])(nil)
+
+type arcList int
+
+const (
+	// Resident entries used once since they were last evicted.
+	recent arcList = iota
+	// Resident entries used at least twice.
+	frequent
+	// Keys recently evicted from recent, without values.
+	recentGhost
+	// Keys recently evicted from frequent, without values.
+	frequentGhost
+)
+
+type arcEntry[K comparable, V any] struct {
+	key     K
+	value   V
+	expires time.Time
+	list    arcList
+}
+
+// ARC is an adaptive replacement cache (Megiddo and Modha). It splits the
+// capacity between entries used once and entries used repeatedly, and
+// remembers the keys it recently evicted from each. A hit on a remembered
+// key shows that side was too small, so the split moves towards it. This
+// resists scans which would flush an LRU cache while still adapting to
+// changes in recency.
+type ARC[K comparable, V any] struct {
+	core[K, V]
+	items map[K]*dll.Element[*arcEntry[K, V]]
+
+	// Most recently used at the front of each.
+	lists [4]dll.DoublyLinkedList[*arcEntry[K, V]]
+
+	// Target size of the recent list.
+	target int
+}
+
+func NewARC[K comparable, V any](opts *Options[K, V]) (*ARC[K, V], error) {
+	core, err := newCore(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ARC[K, V]{
+		core:  core,
+		items: make(map[K]*dll.Element[*arcEntry[K, V]], 2*opts.Capacity),
+	}, nil
+}
+
+func (c *ARC[K, V]) size(list arcList) int {
+	return c.lists[list].Len()
+}
+
+func (c *ARC[K, V]) resident(el *dll.Element[*arcEntry[K, V]]) bool {
+	return el.Value.list == recent || el.Value.list == frequent
+}
+
+func (c *ARC[K, V]) move(el *dll.Element[*arcEntry[K, V]], to arcList) {
+	e := el.Value
+	c.lists[e.list].Remove(el)
+	e.list = to
+	c.items[e.key] = c.lists[to].InsertFront(e)
+}
+
+func (c *ARC[K, V]) remove(el *dll.Element[*arcEntry[K, V]]) {
+	c.lists[el.Value.list].Remove(el)
+	delete(c.items, el.Value.key)
+}
+
+// Forget the least recent key of a ghost list.
+func (c *ARC[K, V]) forget(list arcList) {
+	if el := c.lists[list].BackElement(); el != nil {
+		c.remove(el)
+	}
+}
+
+// Evict a resident entry to make room, from recent if it is over its target
+// and otherwise from frequent, remembering its key.
+func (c *ARC[K, V]) replace(ghostHit bool) {
+	from, to := frequent, frequentGhost
+	if n := c.size(recent); n > 0 && (n > c.target || ghostHit && n == c.target) || c.size(frequent) == 0 {
+		from, to = recent, recentGhost
+	}
+
+	el := c.lists[from].BackElement()
+	e := el.Value
+	c.evicted(e.key, e.value, EvictCapacity)
+	e.value = *new(V)
+	c.move(el, to)
+}
+
+func (c *ARC[K, V]) full() bool {
+	return c.size(recent)+c.size(frequent) >= c.capacity
+}
+
+func (c *ARC[K, V]) Get(key K) (V, bool) {
+	var result V
+
+	el, ok := c.items[key]
+	if !ok || !c.resident(el) {
+		c.stats.Misses++
+		return result, false
+	}
+
+	e := el.Value
+	if c.expired(e.expires) {
+		c.remove(el)
+		c.evicted(e.key, e.value, EvictExpired)
+		c.stats.Misses++
+		return result, false
+	}
+
+	c.move(el, frequent)
+	c.stats.Hits++
+
+	return e.value, true
+}
+
+func (c *ARC[K, V]) Peek(key K) (V, bool) {
+	if el, ok := c.items[key]; ok && c.resident(el) && !c.expired(el.Value.expires) {
+		return el.Value.value, true
+	}
+
+	var result V
+	return result, false
+}
+
+func (c *ARC[K, V]) Put(key K, value V) {
+	if el, ok := c.items[key]; ok {
+		e := el.Value
+		switch e.list {
+		case recentGhost:
+			c.target = min(c.capacity, c.target+max(c.size(frequentGhost)/c.size(recentGhost), 1))
+			if c.full() {
+				c.replace(false)
+			}
+		case frequentGhost:
+			c.target = max(0, c.target-max(c.size(recentGhost)/c.size(frequentGhost), 1))
+			if c.full() {
+				c.replace(true)
+			}
+		}
+
+		e.value = value
+		e.expires = c.expiry()
+		c.move(el, frequent)
+		return
+	}
+
+	// Keep the recent side within capacity and everything within twice that.
+	if c.size(recent)+c.size(recentGhost) >= c.capacity {
+		if c.size(recentGhost) > 0 {
+			c.forget(recentGhost)
+		} else {
+			el := c.lists[recent].BackElement()
+			c.remove(el)
+			c.evicted(el.Value.key, el.Value.value, EvictCapacity)
+		}
+	} else if len(c.items) >= 2*c.capacity {
+		c.forget(frequentGhost)
+	}
+	if c.full() {
+		c.replace(false)
+	}
+
+	e := &arcEntry[K, V]{key: key, value: value, expires: c.expiry(), list: recent}
+	c.items[key] = c.lists[recent].InsertFront(e)
+}
+
+// Delete removes a resident key. It also forgets the key if it was
+// recently evicted, but then reports false.
+func (c *ARC[K, V]) Delete(key K) bool {
+	el, ok := c.items[key]
+	if !ok {
+		return false
+	}
+	c.remove(el)
+	return c.resident(el)
+}
+
+func (c *ARC[K, V]) Len() int {
+	return c.size(recent) + c.size(frequent)
+}
+
+func (c *ARC[K, V]) IsEmpty() bool {
+	return c.Len() == 0
+}
+
+func (c *ARC[K, V]) Clear() {
+	for i := range c.lists {
+		c.lists[i].Clear()
+	}
+	clear(c.items)
+	c.target = 0
+}
@@ -0,0 +1,184 @@
+// Package cache provides fixed capacity key-value caches with different
+// eviction policies behind a common interface: LRU, LFU, ARC and 2Q.
+//
+// The caches are not safe for concurrent use. Wrap them in a Sharded cache
+// to share one between goroutines.
+
+package cache
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jdavasligil/golang-dsa/abstract/container"
+)
+
+type Cache[K comparable, V any] interface {
+	container.Sized
+	container.Clearable
+
+	// Get returns the value for key, counting as a use of it.
+	Get(key K) (V, bool)
+
+	// Peek returns the value for key without counting as a use or updating
+	// the statistics.
+	Peek(key K) (V, bool)
+
+	// Put inserts or replaces the value for key, evicting another entry if
+	// the cache is full.
+	Put(key K, value V)
+
+	// Delete removes key without calling the eviction callback.
+	Delete(key K) bool
+
+	Cap() int
+	Stats() Stats
+}
+
+type CacheConstraintError struct {
+	Constraint string
+}
+
+func (e *CacheConstraintError) Error() string {
+	return fmt.Sprintf("Constraint violated: %s", e.Constraint)
+}
+
+func (e *CacheConstraintError) Is(target error) bool {
+	_, ok := target.(*CacheConstraintError)
+	return ok
+}
+
+type EvictReason int
+
+const (
+	// The entry was evicted to make room for another.
+	EvictCapacity EvictReason = iota
+
+	// The entry outlived the TTL.
+	EvictExpired
+)
+
+func (r EvictReason) String() string {
+	switch r {
+	case EvictCapacity:
+		return "capacity"
+	case EvictExpired:
+		return "expired"
+	}
+	return fmt.Sprintf("EvictReason(%d)", int(r))
+}
+
+type Options[K comparable, V any] struct {
+	// Maximum number of entries.
+	Capacity int
+
+	// Entries expire this long after they were last Put. Zero never
+	// expires. Expired entries are removed when next looked up, so Len may
+	// include them until then.
+	TTL time.Duration
+
+	// OnEvict is called with each entry removed by the cache itself, but
+	// not for Delete or Clear.
+	OnEvict func(key K, value V, reason EvictReason)
+
+	// Clock used for TTL. Defaults to time.Now.
+	Now func() time.Time
+}
+
+type Stats struct {
+	Hits        uint64
+	Misses      uint64
+	Evictions   uint64
+	Expirations uint64
+}
+
+// HitRatio returns the fraction of Get calls which were hits.
+func (s Stats) HitRatio() float64 {
+	if s.Hits+s.Misses == 0 {
+		return 0
+	}
+	return float64(s.Hits) / float64(s.Hits+s.Misses)
+}
+
+func (s Stats) add(other Stats) Stats {
+	return Stats{
+		Hits:        s.Hits + other.Hits,
+		Misses:      s.Misses + other.Misses,
+		Evictions:   s.Evictions + other.Evictions,
+		Expirations: s.Expirations + other.Expirations,
+	}
+}
+
+// Configuration and bookkeeping shared by every policy.
+type core[K comparable, V any] struct {
+	capacity int
+	ttl      time.Duration
+	onEvict  func(K, V, EvictReason)
+	now      func() time.Time
+	stats    Stats
+}
+
+func newCore[K comparable, V any](opts *Options[K, V]) (core[K, V], error) {
+	var errs error
+
+	if opts.Capacity < 1 {
+		errs = errors.Join(errs, &CacheConstraintError{
+			fmt.Sprintf("Capacity %d >= 1", opts.Capacity),
+		})
+	}
+
+	if opts.TTL < 0 {
+		errs = errors.Join(errs, &CacheConstraintError{
+			fmt.Sprintf("TTL %v >= 0", opts.TTL),
+		})
+	}
+
+	if errs != nil {
+		return core[K, V]{}, errs
+	}
+
+	now := opts.Now
+	if now == nil {
+		now = time.Now
+	}
+
+	return core[K, V]{
+		capacity: opts.Capacity,
+		ttl:      opts.TTL,
+		onEvict:  opts.OnEvict,
+		now:      now,
+	}, nil
+}
+
+// Expiry time for an entry Put now, or zero if entries never expire.
+func (c *core[K, V]) expiry() time.Time {
+	if c.ttl == 0 {
+		return time.Time{}
+	}
+	return c.now().Add(c.ttl)
+}
+
+func (c *core[K, V]) expired(expires time.Time) bool {
+	return !expires.IsZero() && !c.now().Before(expires)
+}
+
+func (c *core[K, V]) evicted(key K, value V, reason EvictReason) {
+	switch reason {
+	case EvictCapacity:
+		c.stats.Evictions++
+	case EvictExpired:
+		c.stats.Expirations++
+	}
+	if c.onEvict != nil {
+		c.onEvict(key, value, reason)
+	}
+}
+
+func (c *core[K, V]) Cap() int {
+	return c.capacity
+}
+
+func (c *core[K, V]) Stats() Stats {
+	return c.stats
+}
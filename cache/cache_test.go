@@ -0,0 +1,371 @@
+package cache
+
+import (
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"sync"
+	"testing"
+	"time"
+)
+
+type eviction struct {
+	key    int
+	reason EvictReason
+}
+
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+type policy struct {
+	name string
+	new  func(opts *Options[int, int]) (Cache[int, int], error)
+}
+
+var policies = []policy{
+	{name: "LRU", new: func(opts *Options[int, int]) (Cache[int, int], error) { return NewLRU(opts) }},
+	{name: "LFU", new: func(opts *Options[int, int]) (Cache[int, int], error) { return NewLFU(opts) }},
+	{name: "ARC", new: func(opts *Options[int, int]) (Cache[int, int], error) { return NewARC(opts) }},
+	{name: "2Q", new: func(opts *Options[int, int]) (Cache[int, int], error) { return NewTwoQ(opts) }},
+	{name: "Sharded", new: func(opts *Options[int, int]) (Cache[int, int], error) {
+		return NewSharded(1, func() (Cache[int, int], error) { return NewLRU(opts) })
+	}},
+}
+
+func TestCache(t *testing.T) {
+	for _, p := range policies {
+		t.Run(p.name, func(t *testing.T) {
+			t.Run("Options", func(t *testing.T) {
+				_, err := p.new(&Options[int, int]{Capacity: 0, TTL: -1})
+				if !errors.Is(err, &CacheConstraintError{}) {
+					t.Errorf("Got: %v  Expected: CacheConstraintError.", err)
+				}
+			})
+
+			t.Run("Capacity", func(t *testing.T) {
+				var evicted []eviction
+				c, _ := p.new(&Options[int, int]{
+					Capacity: 4,
+					OnEvict: func(k, v int, reason EvictReason) {
+						if v != -k {
+							t.Errorf("Evicted key %d with value %d", k, v)
+						}
+						evicted = append(evicted, eviction{k, reason})
+					},
+				})
+
+				for k := range 10 {
+					c.Put(k, -k)
+					if c.Len() > 4 {
+						t.Fatalf("Len %d exceeds capacity 4", c.Len())
+					}
+				}
+				if c.Cap() != 4 || len(evicted) != 6 || c.Stats().Evictions != 6 {
+					t.Errorf("Cap %d with %d evictions (%d counted)", c.Cap(), len(evicted), c.Stats().Evictions)
+				}
+				for _, e := range evicted {
+					if e.reason != EvictCapacity {
+						t.Errorf("Key %d evicted for %v", e.key, e.reason)
+					}
+				}
+
+				// The last key put is always present.
+				if v, ok := c.Get(9); !ok || v != -9 {
+					t.Errorf("Get(9) = %d (%t) != expected -9", v, ok)
+				}
+				if !c.Delete(9) || c.Delete(9) {
+					t.Error("Delete reported the wrong presence")
+				}
+				c.Clear()
+				if !c.IsEmpty() || len(evicted) != 6 {
+					t.Error("Clear left entries or called OnEvict")
+				}
+			})
+
+			t.Run("TTL", func(t *testing.T) {
+				clock := &fakeClock{now: time.Unix(0, 0)}
+				var evicted []eviction
+				c, _ := p.new(&Options[int, int]{
+					Capacity: 4,
+					TTL:      time.Minute,
+					Now:      clock.Now,
+					OnEvict:  func(k, v int, reason EvictReason) { evicted = append(evicted, eviction{k, reason}) },
+				})
+
+				c.Put(1, 1)
+				c.Put(2, 2)
+				clock.now = clock.now.Add(40 * time.Second)
+				c.Put(2, 2)
+				clock.now = clock.now.Add(40 * time.Second)
+
+				if _, ok := c.Peek(1); ok {
+					t.Error("Peek returned an expired entry")
+				}
+				if _, ok := c.Get(1); ok {
+					t.Error("Get returned an expired entry")
+				}
+				if _, ok := c.Get(2); !ok {
+					t.Error("Put did not refresh the TTL")
+				}
+				if len(evicted) != 1 || evicted[0] != (eviction{1, EvictExpired}) {
+					t.Errorf("Evictions %v != expected [{1 expired}]", evicted)
+				}
+
+				stats := c.Stats()
+				if stats != (Stats{Hits: 1, Misses: 1, Expirations: 1}) || stats.HitRatio() != 0.5 {
+					t.Errorf("Stats %+v with hit ratio %.2f", stats, stats.HitRatio())
+				}
+			})
+		})
+	}
+}
+
+func TestLRU(t *testing.T) {
+	c, _ := NewLRU(&Options[int, int]{Capacity: 3})
+	c.Put(1, 1)
+	c.Put(2, 2)
+	c.Put(3, 3)
+	c.Get(1)
+	c.Peek(2)
+	c.Put(4, 4)
+
+	if _, ok := c.Peek(2); ok {
+		t.Error("Least recently used key 2 was not evicted")
+	}
+	if _, ok := c.Peek(1); !ok {
+		t.Error("Recently used key 1 was evicted")
+	}
+}
+
+// An expired entry makes room before the least recently used live entry is
+// evicted.
+func TestLRUReclaimsExpired(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	var evicted []eviction
+	c, _ := NewLRU(&Options[int, int]{
+		Capacity: 3,
+		TTL:      time.Minute,
+		Now:      clock.Now,
+		OnEvict:  func(k, v int, reason EvictReason) { evicted = append(evicted, eviction{k, reason}) },
+	})
+
+	c.Put(1, 1)
+	clock.now = clock.now.Add(30 * time.Second)
+	c.Put(2, 2)
+	c.Put(3, 3)
+	c.Get(1)
+	clock.now = clock.now.Add(40 * time.Second)
+
+	// 2 is least recently used but 1 has expired.
+	c.Put(4, 4)
+	if len(evicted) != 1 || evicted[0] != (eviction{1, EvictExpired}) {
+		t.Errorf("Evictions %v != expected [{1 expired}]", evicted)
+	}
+	for _, k := range []int{2, 3, 4} {
+		if _, ok := c.Peek(k); !ok {
+			t.Errorf("Live key %d was evicted", k)
+		}
+	}
+
+	c.Put(5, 5)
+	if len(evicted) != 2 || evicted[1] != (eviction{2, EvictCapacity}) {
+		t.Errorf("Evictions %v != expected [{1 expired} {2 capacity}]", evicted)
+	}
+}
+
+func TestLFU(t *testing.T) {
+	c, _ := NewLFU(&Options[int, int]{Capacity: 3})
+	c.Put(1, 1)
+	c.Put(2, 2)
+	c.Put(3, 3)
+	for range 3 {
+		c.Get(1)
+	}
+	c.Get(2)
+	c.Get(3)
+	c.Get(2)
+
+	// 3 and 4 have been used the least, and 4 less recently.
+	c.Put(4, 4)
+	if _, ok := c.Peek(3); ok {
+		t.Error("Least frequently used key 3 was not evicted")
+	}
+	c.Put(5, 5)
+	if _, ok := c.Peek(4); ok {
+		t.Error("Least frequently used key 4 was not evicted")
+	}
+	for _, k := range []int{1, 2, 5} {
+		if _, ok := c.Peek(k); !ok {
+			t.Errorf("Key %d was evicted", k)
+		}
+	}
+
+	// Deleting the only entry with the lowest count must not break eviction.
+	c.Delete(5)
+	c.Put(6, 6)
+	c.Put(7, 7)
+	if _, ok := c.Peek(6); ok {
+		t.Error("Least frequently used key 6 was not evicted")
+	}
+}
+
+// A working set used repeatedly survives a scan of keys used once, which
+// would flush an LRU cache.
+func TestARCScanResistance(t *testing.T) {
+	const capacity = 100
+
+	hits := map[string]uint64{}
+	for _, p := range policies[:3] {
+		c, _ := p.new(&Options[int, int]{Capacity: capacity})
+		scan := 1000
+
+		for round := range 20 {
+			for k := range capacity / 2 {
+				if _, ok := c.Get(k); !ok {
+					c.Put(k, k)
+				}
+			}
+			if round%2 == 1 {
+				for range capacity {
+					c.Put(scan, scan)
+					scan++
+				}
+			}
+		}
+		hits[p.name] = c.Stats().Hits
+	}
+
+	if hits["ARC"] <= hits["LRU"] {
+		t.Errorf("ARC hits %d <= LRU hits %d", hits["ARC"], hits["LRU"])
+	}
+}
+
+func TestARCModel(t *testing.T) {
+	rng := rand.New(rand.NewPCG(1, 2))
+	c, _ := NewARC(&Options[int, int]{Capacity: 16})
+	values := map[int]int{}
+
+	for step := range 20000 {
+		k := int(rng.ExpFloat64() * 20)
+		switch rng.IntN(4) {
+		case 0, 1:
+			c.Put(k, step)
+			values[k] = step
+		case 2:
+			if v, ok := c.Get(k); ok && v != values[k] {
+				t.Fatalf("Step %d: Get(%d) = %d != last put %d", step, k, v, values[k])
+			}
+		default:
+			c.Delete(k)
+		}
+
+		sizes := [4]int{c.size(recent), c.size(frequent), c.size(recentGhost), c.size(frequentGhost)}
+		if sizes[recent]+sizes[frequent] > 16 || sizes[recent]+sizes[recentGhost] > 16 || len(c.items) > 32 {
+			t.Fatalf("Step %d: list sizes %v", step, sizes)
+		}
+		if c.target < 0 || c.target > 16 {
+			t.Fatalf("Step %d: target %d out of range", step, c.target)
+		}
+	}
+}
+
+func TestTwoQ(t *testing.T) {
+	c, _ := NewTwoQ(&Options[int, int]{Capacity: 8})
+	if c.inCap != 2 || c.ghostCap != 4 {
+		t.Fatalf("Queue sizes %d and %d != expected 2 and 4", c.inCap, c.ghostCap)
+	}
+
+	// 0 is evicted from the new queue, remembered, and promoted when put
+	// again.
+	for k := range 9 {
+		c.Put(k, k)
+	}
+	if _, ok := c.Peek(0); ok || c.size(twoQGhost) != 1 {
+		t.Fatalf("Key 0 resident or not remembered")
+	}
+	c.Put(0, 0)
+	if el := c.items[0]; el == nil || el.Value.list != twoQMain {
+		t.Fatal("Remembered key 0 was not promoted")
+	}
+
+	// A scan of new keys only cycles through the new queue.
+	for k := 100; k < 200; k++ {
+		c.Put(k, k)
+	}
+	if _, ok := c.Peek(0); !ok {
+		t.Error("Scan evicted the promoted key 0")
+	}
+	sizes := [3]int{c.size(twoQIn), c.size(twoQMain), c.size(twoQGhost)}
+	if sizes[twoQIn]+sizes[twoQMain] != 8 || sizes[twoQGhost] > 4 || len(c.items) != 8+sizes[twoQGhost] {
+		t.Errorf("List sizes %v with %d items", sizes, len(c.items))
+	}
+}
+
+func TestSharded(t *testing.T) {
+	if _, err := NewSharded(0, func() (Cache[int, int], error) { return NewLRU(&Options[int, int]{Capacity: 1}) }); !errors.Is(err, &CacheConstraintError{}) {
+		t.Errorf("Got: %v  Expected: CacheConstraintError.", err)
+	}
+
+	var mu sync.Mutex
+	evictions := 0
+	s, err := NewSharded(8, func() (Cache[int, int], error) {
+		return NewLFU(&Options[int, int]{
+			Capacity: 16,
+			OnEvict: func(k, v int, reason EvictReason) {
+				mu.Lock()
+				evictions++
+				mu.Unlock()
+			},
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var wg sync.WaitGroup
+	for g := range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			rng := rand.New(rand.NewPCG(uint64(g), 0))
+			for range 5000 {
+				k := rng.IntN(512)
+				if v, ok := s.Get(k); ok && v != k*2 {
+					t.Errorf("Get(%d) = %d != expected %d", k, v, k*2)
+					return
+				}
+				s.Put(k, k*2)
+			}
+		}()
+	}
+	wg.Wait()
+
+	stats := s.Stats()
+	if s.Cap() != 128 || s.Len() > 128 || stats.Hits+stats.Misses != 40000 || int(stats.Evictions) != evictions {
+		t.Errorf("Cap %d Len %d Stats %+v with %d callbacks", s.Cap(), s.Len(), stats, evictions)
+	}
+}
+
+func BenchmarkCache(b *testing.B) {
+	const keys = 1 << 12
+	for _, p := range policies[:3] {
+		for _, capacity := range []int{keys / 16, keys / 4} {
+			b.Run(fmt.Sprintf("%s/Capacity%d", p.name, capacity), func(b *testing.B) {
+				c, _ := p.new(&Options[int, int]{Capacity: capacity})
+				rng := rand.New(rand.NewPCG(1, 2))
+				for b.Loop() {
+					k := int(rng.ExpFloat64() * keys / 8)
+					if _, ok := c.Get(k); !ok {
+						c.Put(k, k)
+					}
+				}
+				b.ReportMetric(c.Stats().HitRatio(), "hit-ratio")
+			})
+		}
+	}
+}
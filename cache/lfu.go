@@ -0,0 +1,149 @@
+package cache
+
+import (
+	"time"
+
+	dll "github.com/jdavasligil/golang-dsa/doubly_linked_list"
+)
+
+var _ Cache[int, int] = (*LFU[int, int])(nil)
+
+type lfuEntry[K comparable, V any] struct {
+	key     K
+	value   V
+	expires time.Time
+	uses    int
+}
+
+// LFU evicts the least frequently used entry, and the least recently used
+// among those. Every operation is O(1): entries are kept in one list per use
+// count, so the victim is always at the back of the list for the lowest
+// count.
+type LFU[K comparable, V any] struct {
+	core[K, V]
+	items map[K]*dll.Element[*lfuEntry[K, V]]
+
+	// Entries by use count, most recently used at the front. Empty lists
+	// are removed.
+	counts map[int]*dll.DoublyLinkedList[*lfuEntry[K, V]]
+
+	// Lowest use count. Only valid while the cache is full: inserting a new
+	// key resets it to 1 and only Get and Put raise it, while any removal
+	// leaves the cache with room so no eviction relies on it.
+	minUses int
+}
+
+func NewLFU[K comparable, V any](opts *Options[K, V]) (*LFU[K, V], error) {
+	core, err := newCore(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LFU[K, V]{
+		core:   core,
+		items:  make(map[K]*dll.Element[*lfuEntry[K, V]], opts.Capacity),
+		counts: make(map[int]*dll.DoublyLinkedList[*lfuEntry[K, V]]),
+	}, nil
+}
+
+func (c *LFU[K, V]) insert(e *lfuEntry[K, V]) {
+	l, ok := c.counts[e.uses]
+	if !ok {
+		l = dll.NewDoublyLinkedList[*lfuEntry[K, V]]()
+		c.counts[e.uses] = l
+	}
+	c.items[e.key] = l.InsertFront(e)
+}
+
+func (c *LFU[K, V]) remove(el *dll.Element[*lfuEntry[K, V]]) {
+	e := el.Value
+	l := c.counts[e.uses]
+	l.Remove(el)
+	if l.IsEmpty() {
+		delete(c.counts, e.uses)
+	}
+	delete(c.items, e.key)
+}
+
+// Move an entry to the list for one more use.
+func (c *LFU[K, V]) use(el *dll.Element[*lfuEntry[K, V]]) {
+	e := el.Value
+	c.remove(el)
+	if e.uses == c.minUses && c.counts[e.uses] == nil {
+		c.minUses++
+	}
+	e.uses++
+	c.insert(e)
+}
+
+func (c *LFU[K, V]) Get(key K) (V, bool) {
+	var result V
+
+	el, ok := c.items[key]
+	if !ok {
+		c.stats.Misses++
+		return result, false
+	}
+
+	e := el.Value
+	if c.expired(e.expires) {
+		c.remove(el)
+		c.evicted(e.key, e.value, EvictExpired)
+		c.stats.Misses++
+		return result, false
+	}
+
+	c.use(el)
+	c.stats.Hits++
+
+	return e.value, true
+}
+
+func (c *LFU[K, V]) Peek(key K) (V, bool) {
+	if el, ok := c.items[key]; ok && !c.expired(el.Value.expires) {
+		return el.Value.value, true
+	}
+
+	var result V
+	return result, false
+}
+
+// Put counts as a use of an existing key.
+func (c *LFU[K, V]) Put(key K, value V) {
+	if el, ok := c.items[key]; ok {
+		el.Value.value = value
+		el.Value.expires = c.expiry()
+		c.use(el)
+		return
+	}
+
+	if len(c.items) >= c.capacity {
+		el := c.counts[c.minUses].BackElement()
+		c.remove(el)
+		c.evicted(el.Value.key, el.Value.value, EvictCapacity)
+	}
+
+	c.insert(&lfuEntry[K, V]{key: key, value: value, expires: c.expiry(), uses: 1})
+	c.minUses = 1
+}
+
+func (c *LFU[K, V]) Delete(key K) bool {
+	el, ok := c.items[key]
+	if ok {
+		c.remove(el)
+	}
+	return ok
+}
+
+func (c *LFU[K, V]) Len() int {
+	return len(c.items)
+}
+
+func (c *LFU[K, V]) IsEmpty() bool {
+	return len(c.items) == 0
+}
+
+func (c *LFU[K, V]) Clear() {
+	clear(c.items)
+	clear(c.counts)
+}
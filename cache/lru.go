@@ -0,0 +1,143 @@
+package cache
+
+import (
+	"time"
+
+	dll "github.com/jdavasligil/golang-dsa/doubly_linked_list"
+)
+
+var _ Cache[int, int] = (*LRU[int, int])(nil)
+
+type lruEntry[K comparable, V any] struct {
+	key     K
+	value   V
+	expires time.Time
+
+	// Position in the expiry order, if there is a TTL.
+	expiry *dll.Element[*lruEntry[K, V]]
+}
+
+// LRU evicts the least recently used entry, after first removing any
+// expired entries.
+type LRU[K comparable, V any] struct {
+	core[K, V]
+	items map[K]*dll.Element[*lruEntry[K, V]]
+
+	// Most recently used at the front.
+	order dll.DoublyLinkedList[*lruEntry[K, V]]
+
+	// Most recently put at the back. Every Put sets the expiry the same TTL
+	// ahead, so the front is always the next entry to expire.
+	expiries dll.DoublyLinkedList[*lruEntry[K, V]]
+}
+
+func NewLRU[K comparable, V any](opts *Options[K, V]) (*LRU[K, V], error) {
+	core, err := newCore(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LRU[K, V]{
+		core:  core,
+		items: make(map[K]*dll.Element[*lruEntry[K, V]], opts.Capacity),
+	}, nil
+}
+
+func (c *LRU[K, V]) remove(el *dll.Element[*lruEntry[K, V]]) {
+	c.order.Remove(el)
+	if el.Value.expiry != nil {
+		c.expiries.Remove(el.Value.expiry)
+	}
+	delete(c.items, el.Value.key)
+}
+
+// Remove every expired entry, so that they make room before a live entry is
+// evicted.
+func (c *LRU[K, V]) reclaim() {
+	for el := c.expiries.FrontElement(); el != nil && c.expired(el.Value.expires); el = c.expiries.FrontElement() {
+		e := el.Value
+		c.remove(c.items[e.key])
+		c.evicted(e.key, e.value, EvictExpired)
+	}
+}
+
+func (c *LRU[K, V]) Get(key K) (V, bool) {
+	var result V
+
+	el, ok := c.items[key]
+	if !ok {
+		c.stats.Misses++
+		return result, false
+	}
+
+	e := el.Value
+	if c.expired(e.expires) {
+		c.remove(el)
+		c.evicted(e.key, e.value, EvictExpired)
+		c.stats.Misses++
+		return result, false
+	}
+
+	c.order.MoveToFront(el)
+	c.stats.Hits++
+
+	return e.value, true
+}
+
+func (c *LRU[K, V]) Peek(key K) (V, bool) {
+	if el, ok := c.items[key]; ok && !c.expired(el.Value.expires) {
+		return el.Value.value, true
+	}
+
+	var result V
+	return result, false
+}
+
+func (c *LRU[K, V]) Put(key K, value V) {
+	if el, ok := c.items[key]; ok {
+		el.Value.value = value
+		el.Value.expires = c.expiry()
+		c.order.MoveToFront(el)
+		if el.Value.expiry != nil {
+			c.expiries.MoveToBack(el.Value.expiry)
+		}
+		return
+	}
+
+	if len(c.items) >= c.capacity {
+		c.reclaim()
+	}
+	if len(c.items) >= c.capacity {
+		el := c.order.BackElement()
+		c.remove(el)
+		c.evicted(el.Value.key, el.Value.value, EvictCapacity)
+	}
+
+	e := &lruEntry[K, V]{key: key, value: value, expires: c.expiry()}
+	if c.ttl > 0 {
+		e.expiry = c.expiries.InsertBack(e)
+	}
+	c.items[key] = c.order.InsertFront(e)
+}
+
+func (c *LRU[K, V]) Delete(key K) bool {
+	el, ok := c.items[key]
+	if ok {
+		c.remove(el)
+	}
+	return ok
+}
+
+func (c *LRU[K, V]) Len() int {
+	return len(c.items)
+}
+
+func (c *LRU[K, V]) IsEmpty() bool {
+	return len(c.items) == 0
+}
+
+func (c *LRU[K, V]) Clear() {
+	c.order.Clear()
+	c.expiries.Clear()
+	clear(c.items)
+}
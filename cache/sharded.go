@@ -0,0 +1,117 @@
+package cache
+
+import (
+	"fmt"
+	"hash/maphash"
+	"sync"
+)
+
+var _ Cache[int, int] = (*Sharded[int, int])(nil)
+
+type shard[K comparable, V any] struct {
+	mu    sync.Mutex
+	cache Cache[K, V]
+}
+
+// Sharded spreads keys over several caches by hash, each behind its own
+// lock, so it is safe for concurrent use with less contention than a single
+// lock. Eviction callbacks run while their shard is locked and must not use
+// the cache.
+//
+// Each shard evicts independently, so the policy holds per shard rather than
+// across the whole cache.
+type Sharded[K comparable, V any] struct {
+	shards []shard[K, V]
+	seed   maphash.Seed
+}
+
+// NewSharded creates count shards with newCache. The capacity of the result
+// is the sum of the shard capacities.
+func NewSharded[K comparable, V any](count int, newCache func() (Cache[K, V], error)) (*Sharded[K, V], error) {
+	if count < 1 {
+		return nil, &CacheConstraintError{fmt.Sprintf("Shard Count %d >= 1", count)}
+	}
+
+	s := &Sharded[K, V]{
+		shards: make([]shard[K, V], count),
+		seed:   maphash.MakeSeed(),
+	}
+	for i := range s.shards {
+		c, err := newCache()
+		if err != nil {
+			return nil, err
+		}
+		s.shards[i].cache = c
+	}
+
+	return s, nil
+}
+
+func (s *Sharded[K, V]) shard(key K) *shard[K, V] {
+	return &s.shards[maphash.Comparable(s.seed, key)%uint64(len(s.shards))]
+}
+
+func (s *Sharded[K, V]) Get(key K) (V, bool) {
+	sh := s.shard(key)
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+	return sh.cache.Get(key)
+}
+
+func (s *Sharded[K, V]) Peek(key K) (V, bool) {
+	sh := s.shard(key)
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+	return sh.cache.Peek(key)
+}
+
+func (s *Sharded[K, V]) Put(key K, value V) {
+	sh := s.shard(key)
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+	sh.cache.Put(key, value)
+}
+
+func (s *Sharded[K, V]) Delete(key K) bool {
+	sh := s.shard(key)
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+	return sh.cache.Delete(key)
+}
+
+// Visit every shard while holding its lock.
+func (s *Sharded[K, V]) each(f func(c Cache[K, V])) {
+	for i := range s.shards {
+		sh := &s.shards[i]
+		sh.mu.Lock()
+		f(sh.cache)
+		sh.mu.Unlock()
+	}
+}
+
+func (s *Sharded[K, V]) Len() int {
+	var n int
+	s.each(func(c Cache[K, V]) { n += c.Len() })
+	return n
+}
+
+func (s *Sharded[K, V]) IsEmpty() bool {
+	return s.Len() == 0
+}
+
+func (s *Sharded[K, V]) Clear() {
+	s.each(func(c Cache[K, V]) { c.Clear() })
+}
+
+func (s *Sharded[K, V]) Cap() int {
+	var n int
+	s.each(func(c Cache[K, V]) { n += c.Cap() })
+	return n
+}
+
+// Stats sums the statistics of every shard.
+func (s *Sharded[K, V]) Stats() Stats {
+	var stats Stats
+	s.each(func(c Cache[K, V]) { stats = stats.add(c.Stats()) })
+	return stats
+}
@@ -0,0 +1,190 @@
+package cache
+
+import (
+	"time"
+
+	dll "github.com/jdavasligil/golang-dsa/doubly_linked_list"
+)
+
+var _ Cache[int, int] = (*TwoQ[int, int])(nil)
+
+type twoQList int
+
+const (
+	// Resident entries used once, in insertion order.
+	twoQIn twoQList = iota
+	// Resident entries used again after they were evicted from twoQIn.
+	twoQMain
+	// Keys recently evicted from twoQIn, without values.
+	twoQGhost
+)
+
+type twoQEntry[K comparable, V any] struct {
+	key     K
+	value   V
+	expires time.Time
+	list    twoQList
+}
+
+// TwoQ is the full 2Q cache (Johnson and Shasha). New keys enter a small
+// FIFO queue and are evicted from it without affecting the main LRU queue.
+// Only keys which are put again while still remembered after that eviction
+// are promoted to the main queue, so a scan of keys used once cannot flush
+// it. Unlike ARC the split between the queues is fixed: a quarter of the
+// capacity for new keys, and half the capacity worth of remembered keys.
+type TwoQ[K comparable, V any] struct {
+	core[K, V]
+	items map[K]*dll.Element[*twoQEntry[K, V]]
+
+	// Most recently inserted or used at the front of each.
+	lists [3]dll.DoublyLinkedList[*twoQEntry[K, V]]
+
+	inCap, ghostCap int
+}
+
+func NewTwoQ[K comparable, V any](opts *Options[K, V]) (*TwoQ[K, V], error) {
+	core, err := newCore(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TwoQ[K, V]{
+		core:     core,
+		items:    make(map[K]*dll.Element[*twoQEntry[K, V]], 2*opts.Capacity),
+		inCap:    max(1, opts.Capacity/4),
+		ghostCap: max(1, opts.Capacity/2),
+	}, nil
+}
+
+func (c *TwoQ[K, V]) size(list twoQList) int {
+	return c.lists[list].Len()
+}
+
+func (c *TwoQ[K, V]) resident(el *dll.Element[*twoQEntry[K, V]]) bool {
+	return el.Value.list != twoQGhost
+}
+
+func (c *TwoQ[K, V]) move(el *dll.Element[*twoQEntry[K, V]], to twoQList) {
+	e := el.Value
+	c.lists[e.list].Remove(el)
+	e.list = to
+	c.items[e.key] = c.lists[to].InsertFront(e)
+}
+
+func (c *TwoQ[K, V]) remove(el *dll.Element[*twoQEntry[K, V]]) {
+	c.lists[el.Value.list].Remove(el)
+	delete(c.items, el.Value.key)
+}
+
+// Evict a resident entry to make room. The oldest new entry goes if its
+// queue is over its share, and its key is remembered. Otherwise the least
+// recently used main entry goes.
+func (c *TwoQ[K, V]) reclaim() {
+	if c.Len() < c.capacity {
+		return
+	}
+
+	if c.size(twoQIn) > c.inCap || c.size(twoQMain) == 0 {
+		el := c.lists[twoQIn].BackElement()
+		e := el.Value
+		c.evicted(e.key, e.value, EvictCapacity)
+		e.value = *new(V)
+		c.move(el, twoQGhost)
+		if c.size(twoQGhost) > c.ghostCap {
+			c.remove(c.lists[twoQGhost].BackElement())
+		}
+		return
+	}
+
+	el := c.lists[twoQMain].BackElement()
+	c.remove(el)
+	c.evicted(el.Value.key, el.Value.value, EvictCapacity)
+}
+
+func (c *TwoQ[K, V]) Get(key K) (V, bool) {
+	var result V
+
+	el, ok := c.items[key]
+	if !ok || !c.resident(el) {
+		c.stats.Misses++
+		return result, false
+	}
+
+	e := el.Value
+	if c.expired(e.expires) {
+		c.remove(el)
+		c.evicted(e.key, e.value, EvictExpired)
+		c.stats.Misses++
+		return result, false
+	}
+
+	// New entries keep their place in the FIFO queue.
+	if e.list == twoQMain {
+		c.lists[twoQMain].MoveToFront(el)
+	}
+	c.stats.Hits++
+
+	return e.value, true
+}
+
+func (c *TwoQ[K, V]) Peek(key K) (V, bool) {
+	if el, ok := c.items[key]; ok && c.resident(el) && !c.expired(el.Value.expires) {
+		return el.Value.value, true
+	}
+
+	var result V
+	return result, false
+}
+
+func (c *TwoQ[K, V]) Put(key K, value V) {
+	el, ok := c.items[key]
+	if ok && c.resident(el) {
+		e := el.Value
+		e.value = value
+		e.expires = c.expiry()
+		if e.list == twoQMain {
+			c.lists[twoQMain].MoveToFront(el)
+		}
+		return
+	}
+
+	c.reclaim()
+
+	// The reclaim may have forgotten the key.
+	if el, ok = c.items[key]; ok {
+		e := el.Value
+		e.value = value
+		e.expires = c.expiry()
+		c.move(el, twoQMain)
+		return
+	}
+
+	e := &twoQEntry[K, V]{key: key, value: value, expires: c.expiry(), list: twoQIn}
+	c.items[key] = c.lists[twoQIn].InsertFront(e)
+}
+
+// Delete removes a resident key. It also forgets the key if it was
+// recently evicted, but then reports false.
+func (c *TwoQ[K, V]) Delete(key K) bool {
+	el, ok := c.items[key]
+	if !ok {
+		return false
+	}
+	c.remove(el)
+	return c.resident(el)
+}
+
+func (c *TwoQ[K, V]) Len() int {
+	return c.size(twoQIn) + c.size(twoQMain)
+}
+
+func (c *TwoQ[K, V]) IsEmpty() bool {
+	return c.Len() == 0
+}
+
+func (c *TwoQ[K, V]) Clear() {
+	for i := range c.lists {
+		c.lists[i].Clear()
+	}
+	clear(c.items)
+}
@@ -0,0 +1,417 @@
+// DoublyLinkedList
+// Deque using a circular doubly linked list with a sentinel node
+
+package doubly_linked_list
+
+import (
+	"iter"
+
+	"github.com/jdavasligil/golang-dsa/abstract/container"
+	"github.com/jdavasligil/golang-dsa/abstract/deque"
+	"github.com/jdavasligil/golang-dsa/internal/owner"
+)
+
+var (
+	_ deque.Deque[int]        = (*DoublyLinkedList[int])(nil)
+	_ container.Sized         = (*DoublyLinkedList[int])(nil)
+	_ container.Clearable     = (*DoublyLinkedList[int])(nil)
+	_ container.Iterable[int] = (*DoublyLinkedList[int])(nil)
+)
+
+var (
+	errEmpty  = &container.EmptyError{Container: "DoublyLinkedList"}
+	errHandle = &container.HandleError{Container: "DoublyLinkedList"}
+)
+
+// Element is a stable handle to a value stored in a DoublyLinkedList.
+type Element[T any] struct {
+	next, prev *Element[T]
+	owner      *owner.Owner
+	sentinel   bool
+
+	Value T
+}
+
+// Next returns the following element or nil at the back of the list.
+func (e *Element[T]) Next() *Element[T] {
+	if p := e.next; p != nil && !p.sentinel {
+		return p
+	}
+	return nil
+}
+
+// Prev returns the preceding element or nil at the front of the list.
+func (e *Element[T]) Prev() *Element[T] {
+	if p := e.prev; p != nil && !p.sentinel {
+		return p
+	}
+	return nil
+}
+
+// The zero value is an empty list ready to use.
+type DoublyLinkedList[T any] struct {
+	root Element[T]
+	id   *owner.Owner
+	size int
+}
+
+func NewDoublyLinkedList[T any]() *DoublyLinkedList[T] {
+	return new(DoublyLinkedList[T]).lazyInit()
+}
+
+func (l *DoublyLinkedList[T]) lazyInit() *DoublyLinkedList[T] {
+	if l.root.next == nil {
+		l.root.next = &l.root
+		l.root.prev = &l.root
+		l.root.sentinel = true
+		l.id = &owner.Owner{}
+	}
+	return l
+}
+
+func (l *DoublyLinkedList[T]) owns(e *Element[T]) bool {
+	l.lazyInit()
+	return e != nil && e.owner != nil && e.owner.Find() == l.id
+}
+
+// Link e after at.
+func (l *DoublyLinkedList[T]) link(e, at *Element[T]) *Element[T] {
+	e.prev = at
+	e.next = at.next
+	e.prev.next = e
+	e.next.prev = e
+	e.owner = l.id
+	l.size++
+	return e
+}
+
+func (l *DoublyLinkedList[T]) unlink(e *Element[T]) {
+	e.prev.next = e.next
+	e.next.prev = e.prev
+	e.next = nil
+	e.prev = nil
+	e.owner = nil
+	l.size--
+}
+
+// Move e after at, both already in the list.
+func (l *DoublyLinkedList[T]) move(e, at *Element[T]) {
+	if e == at || e == at.next {
+		return
+	}
+	e.prev.next = e.next
+	e.next.prev = e.prev
+
+	e.prev = at
+	e.next = at.next
+	e.prev.next = e
+	e.next.prev = e
+}
+
+func (l *DoublyLinkedList[T]) Len() int {
+	return l.size
+}
+
+func (l *DoublyLinkedList[T]) IsEmpty() bool {
+	return l.size == 0
+}
+
+func (l *DoublyLinkedList[T]) FrontElement() *Element[T] {
+	if l.size == 0 {
+		return nil
+	}
+	return l.root.next
+}
+
+func (l *DoublyLinkedList[T]) BackElement() *Element[T] {
+	if l.size == 0 {
+		return nil
+	}
+	return l.root.prev
+}
+
+func (l *DoublyLinkedList[T]) InsertFront(value T) *Element[T] {
+	l.lazyInit()
+	return l.link(&Element[T]{Value: value}, &l.root)
+}
+
+func (l *DoublyLinkedList[T]) InsertBack(value T) *Element[T] {
+	l.lazyInit()
+	return l.link(&Element[T]{Value: value}, l.root.prev)
+}
+
+func (l *DoublyLinkedList[T]) InsertBefore(value T, mark *Element[T]) (*Element[T], error) {
+	if !l.owns(mark) {
+		return nil, errHandle
+	}
+	return l.link(&Element[T]{Value: value}, mark.prev), nil
+}
+
+func (l *DoublyLinkedList[T]) InsertAfter(value T, mark *Element[T]) (*Element[T], error) {
+	if !l.owns(mark) {
+		return nil, errHandle
+	}
+	return l.link(&Element[T]{Value: value}, mark), nil
+}
+
+// Remove unlinks e and returns its value. The handle is invalid afterwards.
+func (l *DoublyLinkedList[T]) Remove(e *Element[T]) (T, error) {
+	var result T
+
+	if !l.owns(e) {
+		return result, errHandle
+	}
+	l.unlink(e)
+
+	return e.Value, nil
+}
+
+func (l *DoublyLinkedList[T]) MoveToFront(e *Element[T]) error {
+	if !l.owns(e) {
+		return errHandle
+	}
+	l.move(e, &l.root)
+	return nil
+}
+
+func (l *DoublyLinkedList[T]) MoveToBack(e *Element[T]) error {
+	if !l.owns(e) {
+		return errHandle
+	}
+	l.move(e, l.root.prev)
+	return nil
+}
+
+func (l *DoublyLinkedList[T]) MoveBefore(e, mark *Element[T]) error {
+	if !l.owns(e) || !l.owns(mark) {
+		return errHandle
+	}
+	l.move(e, mark.prev)
+	return nil
+}
+
+func (l *DoublyLinkedList[T]) MoveAfter(e, mark *Element[T]) error {
+	if !l.owns(e) || !l.owns(mark) {
+		return errHandle
+	}
+	l.move(e, mark)
+	return nil
+}
+
+// Take every element of other and link them after at in O(1). Handles into
+// other remain valid and now belong to l. other is left empty.
+func (l *DoublyLinkedList[T]) splice(other *DoublyLinkedList[T], at *Element[T]) {
+	other.lazyInit()
+	if other == l || other.size == 0 {
+		return
+	}
+
+	first := other.root.next
+	last := other.root.prev
+
+	first.prev = at
+	last.next = at.next
+	at.next.prev = last
+	at.next = first
+	l.size += other.size
+
+	other.id.Forward(l.id)
+	other.id = &owner.Owner{}
+	other.root.next = &other.root
+	other.root.prev = &other.root
+	other.size = 0
+}
+
+// SpliceBack moves every element of other to the back of l in O(1).
+func (l *DoublyLinkedList[T]) SpliceBack(other *DoublyLinkedList[T]) {
+	l.lazyInit()
+	l.splice(other, l.root.prev)
+}
+
+// SpliceFront moves every element of other to the front of l in O(1).
+func (l *DoublyLinkedList[T]) SpliceFront(other *DoublyLinkedList[T]) {
+	l.lazyInit()
+	l.splice(other, &l.root)
+}
+
+func (l *DoublyLinkedList[T]) PushBack(element T) error {
+	l.InsertBack(element)
+	return nil
+}
+
+func (l *DoublyLinkedList[T]) PushFront(element T) error {
+	l.InsertFront(element)
+	return nil
+}
+
+func (l *DoublyLinkedList[T]) PopBack() (T, error) {
+	if l.size == 0 {
+		var result T
+		return result, errEmpty
+	}
+	return l.Remove(l.root.prev)
+}
+
+func (l *DoublyLinkedList[T]) PopFront() (T, error) {
+	if l.size == 0 {
+		var result T
+		return result, errEmpty
+	}
+	return l.Remove(l.root.next)
+}
+
+func (l *DoublyLinkedList[T]) Back() (T, error) {
+	var result T
+
+	if l.size == 0 {
+		return result, errEmpty
+	}
+
+	return l.root.prev.Value, nil
+}
+
+func (l *DoublyLinkedList[T]) Front() (T, error) {
+	var result T
+
+	if l.size == 0 {
+		return result, errEmpty
+	}
+
+	return l.root.next.Value, nil
+}
+
+// Clear removes every element. Existing handles become invalid.
+func (l *DoublyLinkedList[T]) Clear() {
+	l.lazyInit()
+	l.id = &owner.Owner{}
+	l.root.next = &l.root
+	l.root.prev = &l.root
+	l.size = 0
+}
+
+// All iterates from front to back.
+func (l *DoublyLinkedList[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for e := l.FrontElement(); e != nil; e = e.Next() {
+			if !yield(e.Value) {
+				return
+			}
+		}
+	}
+}
+
+// Backward iterates from back to front.
+func (l *DoublyLinkedList[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for e := l.BackElement(); e != nil; e = e.Prev() {
+			if !yield(e.Value) {
+				return
+			}
+		}
+	}
+}
+
+// Cursor starts positioned before the front of the list.
+func (l *DoublyLinkedList[T]) Cursor() *Cursor[T] {
+	l.lazyInit()
+	return &Cursor[T]{list: l, cur: &l.root}
+}
+
+// Cursor traverses a list while allowing it to be modified. All changes made
+// during traversal should go through the cursor.
+//
+// The list is treated as a ring with a gap between the back and the front.
+// When the cursor is on the gap, Next moves to the front and Prev moves to
+// the back.
+type Cursor[T any] struct {
+	list *DoublyLinkedList[T]
+	cur  *Element[T]
+}
+
+// Whether the cursor is on the gap or an element still in its list. An
+// element removed other than through the cursor, or by Clear, leaves the
+// cursor stale.
+func (c *Cursor[T]) valid() bool {
+	return c.cur == &c.list.root || c.list.owns(c.cur)
+}
+
+// Next advances the cursor. Returns false when it reaches the gap, or if the
+// cursor is stale.
+func (c *Cursor[T]) Next() bool {
+	if !c.valid() {
+		return false
+	}
+	c.cur = c.cur.next
+	return !c.cur.sentinel
+}
+
+// Prev moves the cursor backwards. Returns false when it reaches the gap, or
+// if the cursor is stale.
+func (c *Cursor[T]) Prev() bool {
+	if !c.valid() {
+		return false
+	}
+	c.cur = c.cur.prev
+	return !c.cur.sentinel
+}
+
+// Element returns the current element, or nil on the gap or if stale.
+func (c *Cursor[T]) Element() *Element[T] {
+	if c.cur.sentinel || !c.valid() {
+		return nil
+	}
+	return c.cur
+}
+
+// Value returns the current value, or the zero value on the gap or if stale.
+func (c *Cursor[T]) Value() T {
+	var result T
+	if c.cur.sentinel || !c.valid() {
+		return result
+	}
+	return c.cur.Value
+}
+
+// Set replaces the current value. Returns false on the gap or if stale.
+func (c *Cursor[T]) Set(value T) bool {
+	if c.cur.sentinel || !c.valid() {
+		return false
+	}
+	c.cur.Value = value
+	return true
+}
+
+// Remove deletes the current element and steps back, so the following call
+// to Next visits the element after the removed one. Returns a
+// container.HandleError if the cursor is stale, or on the gap since there is
+// no current element to remove.
+func (c *Cursor[T]) Remove() (T, error) {
+	if !c.valid() || c.cur.sentinel {
+		var result T
+		return result, errHandle
+	}
+
+	e := c.cur
+	c.cur = e.prev
+	c.list.unlink(e)
+
+	return e.Value, nil
+}
+
+// InsertBefore links a value before the cursor. On the gap it is inserted at
+// the back. Returns nil if the cursor is stale.
+func (c *Cursor[T]) InsertBefore(value T) *Element[T] {
+	if !c.valid() {
+		return nil
+	}
+	return c.list.link(&Element[T]{Value: value}, c.cur.prev)
+}
+
+// InsertAfter links a value after the cursor. On the gap it is inserted at
+// the front. Returns nil if the cursor is stale.
+func (c *Cursor[T]) InsertAfter(value T) *Element[T] {
+	if !c.valid() {
+		return nil
+	}
+	return c.list.link(&Element[T]{Value: value}, c.cur)
+}
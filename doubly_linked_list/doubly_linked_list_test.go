@@ -0,0 +1,238 @@
+package doubly_linked_list
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/jdavasligil/golang-dsa/abstract/container"
+	"github.com/jdavasligil/golang-dsa/abstract/deque"
+	"github.com/jdavasligil/golang-dsa/abstract/deque/dequetest"
+)
+
+func listOf(values string) *DoublyLinkedList[rune] {
+	l := NewDoublyLinkedList[rune]()
+	for _, r := range values {
+		l.PushBack(r)
+	}
+	return l
+}
+
+func checkList(t *testing.T, l *DoublyLinkedList[rune], expected string) {
+	t.Helper()
+
+	if got := string(slices.Collect(l.All())); got != expected {
+		t.Errorf("Forward = %s != expected %s", got, expected)
+	}
+
+	backward := []rune(expected)
+	slices.Reverse(backward)
+	if got := string(slices.Collect(l.Backward())); got != string(backward) {
+		t.Errorf("Backward = %s != expected %s", got, string(backward))
+	}
+
+	if l.Len() != len([]rune(expected)) {
+		t.Errorf("Len %d != expected %d", l.Len(), len([]rune(expected)))
+	}
+}
+
+func TestDoublyLinkedList(t *testing.T) {
+	t.Run("ZeroValue", func(t *testing.T) {
+		var l DoublyLinkedList[rune]
+
+		if _, err := l.PopFront(); !errors.Is(err, &container.EmptyError{}) {
+			t.Errorf("Got: %v  Expected: container.EmptyError.", err)
+		}
+		l.PushBack('a')
+		checkList(t, &l, "a")
+	})
+
+	t.Run("InsertAndMove", func(t *testing.T) {
+		l := NewDoublyLinkedList[rune]()
+
+		b := l.InsertBack('b')
+		l.InsertFront('a')
+		d := l.InsertBack('d')
+		if _, err := l.InsertAfter('c', b); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := l.InsertBefore('0', l.FrontElement()); err != nil {
+			t.Fatal(err)
+		}
+		checkList(t, l, "0abcd")
+
+		l.MoveToFront(d)
+		checkList(t, l, "d0abc")
+		l.MoveToBack(d)
+		checkList(t, l, "0abcd")
+		l.MoveBefore(d, b)
+		checkList(t, l, "0adbc")
+		l.MoveAfter(d, l.BackElement())
+		checkList(t, l, "0abcd")
+		l.MoveAfter(d, d)
+		checkList(t, l, "0abcd")
+
+		val, err := l.Remove(b)
+		if err != nil || val != 'b' {
+			t.Errorf("Remove = %c (%v) != expected b", val, err)
+		}
+		checkList(t, l, "0acd")
+
+		if _, err := l.Remove(b); !errors.Is(err, &container.HandleError{}) {
+			t.Errorf("Removing twice Got: %v  Expected: container.HandleError.", err)
+		}
+	})
+
+	t.Run("ForeignHandles", func(t *testing.T) {
+		a := listOf("abc")
+		b := listOf("xyz")
+		foreign := b.FrontElement()
+
+		tests := []error{
+			second(a.Remove(foreign)),
+			a.MoveToFront(foreign),
+			a.MoveToBack(foreign),
+			a.MoveAfter(a.FrontElement(), foreign),
+			second(a.InsertBefore('q', foreign)),
+			second(a.InsertAfter('q', nil)),
+		}
+
+		for i, err := range tests {
+			if !errors.Is(err, &container.HandleError{}) {
+				t.Errorf("Test %d: Got: %v  Expected: container.HandleError.", i, err)
+			}
+		}
+		checkList(t, a, "abc")
+		checkList(t, b, "xyz")
+	})
+
+	t.Run("Splice", func(t *testing.T) {
+		a := listOf("abc")
+		b := listOf("xyz")
+		c := listOf("123")
+		y := b.FrontElement().Next()
+		two := c.FrontElement().Next()
+
+		a.SpliceBack(b)
+		checkList(t, a, "abcxyz")
+		checkList(t, b, "")
+
+		a.SpliceFront(c)
+		checkList(t, a, "123abcxyz")
+
+		// Handles follow their elements into the new list.
+		if err := a.MoveToFront(y); err != nil {
+			t.Errorf("Spliced handle rejected: %v", err)
+		}
+		if _, err := a.Remove(two); err != nil {
+			t.Errorf("Spliced handle rejected: %v", err)
+		}
+		checkList(t, a, "y13abcxz")
+
+		// The emptied list is reusable and does not own the moved elements.
+		b.PushBack('!')
+		if _, err := b.Remove(y); !errors.Is(err, &container.HandleError{}) {
+			t.Errorf("Got: %v  Expected: container.HandleError.", err)
+		}
+		a.SpliceBack(b)
+		a.SpliceBack(a)
+		checkList(t, a, "y13abcxz!")
+	})
+
+	t.Run("Clear", func(t *testing.T) {
+		l := listOf("abc")
+		e := l.FrontElement()
+
+		l.Clear()
+		checkList(t, l, "")
+		if err := l.MoveToBack(e); !errors.Is(err, &container.HandleError{}) {
+			t.Errorf("Got: %v  Expected: container.HandleError.", err)
+		}
+	})
+
+	t.Run("Cursor", func(t *testing.T) {
+		l := listOf("abcdef")
+		c := l.Cursor()
+
+		// Remove vowels, duplicate consonants, uppercase 'd'.
+		for c.Next() {
+			switch c.Value() {
+			case 'a', 'e':
+				c.Remove()
+			case 'd':
+				c.Set('D')
+			default:
+				c.InsertAfter(c.Value())
+				c.Next()
+			}
+		}
+		checkList(t, l, "bbccDff")
+
+		if c.Element() != nil || c.Set('z') {
+			t.Error("Cursor should be on the gap after traversal")
+		}
+		if _, err := c.Remove(); !errors.Is(err, &container.HandleError{}) || errors.Is(err, &container.EmptyError{}) {
+			t.Errorf("Got: %v  Expected: container.HandleError.", err)
+		}
+
+		c.InsertBefore('<')
+		c.InsertAfter('>')
+		checkList(t, l, ">bbccDff<")
+
+		var backward []rune
+		for c.Prev() {
+			backward = append(backward, c.Value())
+		}
+		if string(backward) != "<ffDccbb>" {
+			t.Errorf("Backward cursor = %s != expected <ffDccbb>", string(backward))
+		}
+	})
+
+	t.Run("StaleCursor", func(t *testing.T) {
+		l := listOf("abc")
+		c := l.Cursor()
+		c.Next()
+		l.Remove(l.FrontElement())
+
+		if _, err := c.Remove(); !errors.Is(err, &container.HandleError{}) {
+			t.Errorf("After Remove Got: %v  Expected: container.HandleError.", err)
+		}
+		if c.Element() != nil || c.Set('x') {
+			t.Error("Stale cursor still exposes its removed element")
+		}
+		if c.Next() || c.Prev() || c.InsertBefore('x') != nil || c.InsertAfter('x') != nil {
+			t.Error("Cursor moved or inserted after its element was removed")
+		}
+		checkList(t, l, "bc")
+
+		c = l.Cursor()
+		c.Next()
+		l.Clear()
+		l.PushBack('z')
+
+		if _, err := c.Remove(); !errors.Is(err, &container.HandleError{}) {
+			t.Errorf("After Clear Got: %v  Expected: container.HandleError.", err)
+		}
+		if c.Next() || c.InsertAfter('x') != nil {
+			t.Error("Cursor moved or inserted after Clear")
+		}
+		checkList(t, l, "z")
+
+		// A cursor on the gap is never stale.
+		c = l.Cursor()
+		l.Clear()
+		c.InsertAfter('y')
+		if !c.Next() || c.Value() != 'y' {
+			t.Error("Cursor on the gap rejected after Clear")
+		}
+		checkList(t, l, "y")
+	})
+}
+
+func TestDoublyLinkedListDequeConformance(t *testing.T) {
+	dequetest.RunDequeTests(t, func(capacity int) deque.Deque[int] { return NewDoublyLinkedList[int]() })
+}
+
+func second[T any](_ T, err error) error {
+	return err
+}
@@ -0,0 +1,272 @@
+// HashMap
+// Open addressing hash map using Robin Hood linear probing
+
+package hashmap
+
+import (
+	"errors"
+	"fmt"
+	"hash/maphash"
+	"iter"
+	"math"
+	"math/bits"
+
+	"github.com/jdavasligil/golang-dsa/abstract/container"
+)
+
+var (
+	_ container.Sized     = (*HashMap[int, int])(nil)
+	_ container.Clearable = (*HashMap[int, int])(nil)
+)
+
+type HashMapConstraintError struct {
+	Constraint string
+}
+
+func (e *HashMapConstraintError) Error() string {
+	return fmt.Sprintf("Constraint violated: %s", e.Constraint)
+}
+
+func (e *HashMapConstraintError) Is(target error) bool {
+	_, ok := target.(*HashMapConstraintError)
+	return ok
+}
+
+const (
+	defaultMaxLoadFactor = 0.875
+	minSlots             = 8
+)
+
+type slot[K comparable, V any] struct {
+	key   K
+	value V
+
+	// One more than the distance from the home slot of key, or 0 if empty.
+	dist uint32
+}
+
+// HashMap keeps every key within a short probe of its home slot by letting
+// an inserted key take the slot of any key closer to its own home (Robin
+// Hood). Deletion shifts the following run back a slot instead of leaving
+// tombstones.
+//
+// The zero value is an empty map ready to use.
+type HashMap[K comparable, V any] struct {
+	slots         []slot[K, V]
+	size          int
+	maxLoadFactor float64
+	seed          maphash.Seed
+	seeded        bool
+}
+
+type HashMapOptions struct {
+	// Number of keys which fit without growing.
+	Capacity int
+
+	// Fraction of slots in use before growing. Zero uses the default 0.875.
+	MaxLoadFactor float64
+}
+
+func NewHashMap[K comparable, V any]() *HashMap[K, V] {
+	return &HashMap[K, V]{}
+}
+
+func NewHashMapWithOptions[K comparable, V any](opts *HashMapOptions) (*HashMap[K, V], error) {
+	var errs error
+
+	if opts.Capacity < 0 {
+		errs = errors.Join(errs, &HashMapConstraintError{
+			fmt.Sprintf("Capacity %d >= 0", opts.Capacity),
+		})
+	}
+
+	if opts.MaxLoadFactor < 0 || opts.MaxLoadFactor >= 1 {
+		errs = errors.Join(errs, &HashMapConstraintError{
+			fmt.Sprintf("0 < Max Load Factor %.2f < 1 (or 0 for default)", opts.MaxLoadFactor),
+		})
+	}
+
+	if errs != nil {
+		return nil, errs
+	}
+
+	m := &HashMap[K, V]{maxLoadFactor: opts.MaxLoadFactor}
+	if opts.Capacity > 0 {
+		m.resize(m.slotsFor(opts.Capacity))
+	}
+
+	return m, nil
+}
+
+func (m *HashMap[K, V]) loadFactor() float64 {
+	if m.maxLoadFactor == 0 {
+		return defaultMaxLoadFactor
+	}
+	return m.maxLoadFactor
+}
+
+// Smallest power of two number of slots holding n keys under the load factor.
+func (m *HashMap[K, V]) slotsFor(n int) int {
+	needed := int(math.Ceil(float64(n) / m.loadFactor()))
+	return max(minSlots, 1<<bits.Len(uint(needed-1)))
+}
+
+func (m *HashMap[K, V]) hash(key K) uint64 {
+	if !m.seeded {
+		m.seed = maphash.MakeSeed()
+		m.seeded = true
+	}
+	return maphash.Comparable(m.seed, key)
+}
+
+func (m *HashMap[K, V]) resize(n int) {
+	old := m.slots
+	m.slots = make([]slot[K, V], n)
+	m.size = 0
+
+	for i := range old {
+		if old[i].dist != 0 {
+			m.insert(old[i].key, old[i].value)
+		}
+	}
+}
+
+// Index of key, or -1.
+func (m *HashMap[K, V]) find(key K) int {
+	if m.size == 0 {
+		return -1
+	}
+
+	mask := len(m.slots) - 1
+	i := int(m.hash(key)) & mask
+	for dist := uint32(1); ; dist++ {
+		s := &m.slots[i]
+		// A key further from home than s would have displaced it.
+		if s.dist < dist {
+			return -1
+		}
+		if s.dist == dist && s.key == key {
+			return i
+		}
+		i = (i + 1) & mask
+	}
+}
+
+// Insert without checking the load factor.
+func (m *HashMap[K, V]) insert(key K, value V) {
+	mask := len(m.slots) - 1
+	i := int(m.hash(key)) & mask
+	cur := slot[K, V]{key: key, value: value, dist: 1}
+
+	for {
+		s := &m.slots[i]
+		if s.dist == 0 {
+			*s = cur
+			m.size++
+			return
+		}
+		if s.dist == cur.dist && s.key == cur.key {
+			s.value = cur.value
+			return
+		}
+		if s.dist < cur.dist {
+			*s, cur = cur, *s
+		}
+		i = (i + 1) & mask
+		cur.dist++
+	}
+}
+
+func (m *HashMap[K, V]) Get(key K) (V, bool) {
+	if i := m.find(key); i >= 0 {
+		return m.slots[i].value, true
+	}
+
+	var result V
+	return result, false
+}
+
+func (m *HashMap[K, V]) Contains(key K) bool {
+	return m.find(key) >= 0
+}
+
+// Put inserts or replaces the value for key. Only new keys can grow the map.
+func (m *HashMap[K, V]) Put(key K, value V) {
+	if i := m.find(key); i >= 0 {
+		m.slots[i].value = value
+		return
+	}
+	if float64(m.size+1) > float64(len(m.slots))*m.loadFactor() {
+		m.resize(max(minSlots, len(m.slots)*2))
+	}
+	m.insert(key, value)
+}
+
+// Delete removes key and reports whether it was present.
+func (m *HashMap[K, V]) Delete(key K) bool {
+	i := m.find(key)
+	if i < 0 {
+		return false
+	}
+
+	mask := len(m.slots) - 1
+	for {
+		next := (i + 1) & mask
+		if m.slots[next].dist <= 1 {
+			break
+		}
+		m.slots[i] = m.slots[next]
+		m.slots[i].dist--
+		i = next
+	}
+	m.slots[i] = slot[K, V]{}
+	m.size--
+
+	return true
+}
+
+func (m *HashMap[K, V]) Len() int {
+	return m.size
+}
+
+func (m *HashMap[K, V]) IsEmpty() bool {
+	return m.size == 0
+}
+
+// Clear removes every key but keeps the allocated slots.
+func (m *HashMap[K, V]) Clear() {
+	clear(m.slots)
+	m.size = 0
+}
+
+// All iterates keys and values in slot order, which is unspecified. The map
+// must not be modified during iteration.
+func (m *HashMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for i := range m.slots {
+			if m.slots[i].dist != 0 && !yield(m.slots[i].key, m.slots[i].value) {
+				return
+			}
+		}
+	}
+}
+
+func (m *HashMap[K, V]) Keys() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for k := range m.All() {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
+
+func (m *HashMap[K, V]) Values() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for _, v := range m.All() {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
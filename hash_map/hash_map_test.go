@@ -0,0 +1,226 @@
+package hashmap
+
+import (
+	"errors"
+	"maps"
+	"math/rand/v2"
+	"strconv"
+	"testing"
+)
+
+// Every key is stored at its recorded distance from home, and no key is
+// further from home than the following key allows.
+func checkInvariants[K comparable, V any](t *testing.T, m *HashMap[K, V]) {
+	t.Helper()
+
+	mask := len(m.slots) - 1
+	var count int
+	for i, s := range m.slots {
+		if s.dist == 0 {
+			continue
+		}
+		count++
+
+		home := int(m.hash(s.key)) & mask
+		if dist := uint32((i-home)&mask) + 1; dist != s.dist {
+			t.Fatalf("Slot %d distance %d != actual %d", i, s.dist, dist)
+		}
+		if next := m.slots[(i+1)&mask]; next.dist > s.dist+1 {
+			t.Fatalf("Slot %d distance %d followed by %d", i, s.dist, next.dist)
+		}
+	}
+
+	if count != m.Len() {
+		t.Fatalf("Occupied slots %d != Len %d", count, m.Len())
+	}
+	if float64(count) > float64(len(m.slots))*m.loadFactor() {
+		t.Fatalf("Load %d/%d exceeds max load factor", count, len(m.slots))
+	}
+}
+
+func TestHashMap(t *testing.T) {
+	t.Run("ZeroValue", func(t *testing.T) {
+		var m HashMap[string, int]
+
+		if _, ok := m.Get("a"); ok || m.Delete("a") {
+			t.Error("Empty map reported a key")
+		}
+		m.Put("a", 1)
+		if val, ok := m.Get("a"); !ok || val != 1 {
+			t.Errorf("Get = %d (%t) != expected 1", val, ok)
+		}
+	})
+
+	t.Run("Options", func(t *testing.T) {
+		_, err := NewHashMapWithOptions[int, int](&HashMapOptions{Capacity: -1, MaxLoadFactor: 1})
+		if !errors.Is(err, &HashMapConstraintError{}) {
+			t.Errorf("Got: %v  Expected: HashMapConstraintError.", err)
+		}
+
+		m, err := NewHashMapWithOptions[int, int](&HashMapOptions{Capacity: 100, MaxLoadFactor: 0.5})
+		if err != nil {
+			t.Fatal(err)
+		}
+		slots := len(m.slots)
+		for i := range 100 {
+			m.Put(i, i)
+		}
+		if len(m.slots) != slots {
+			t.Errorf("Map grew from %d to %d slots within capacity", slots, len(m.slots))
+		}
+		checkInvariants(t, m)
+	})
+
+	t.Run("ReplaceAtLoad", func(t *testing.T) {
+		m := NewHashMap[int, int]()
+		for i := range int(minSlots * defaultMaxLoadFactor) {
+			m.Put(i, i)
+		}
+		slots := len(m.slots)
+		m.Put(0, 100)
+		if len(m.slots) != slots {
+			t.Errorf("Replacing a key grew the map from %d to %d slots", slots, len(m.slots))
+		}
+		if val, _ := m.Get(0); val != 100 {
+			t.Errorf("Get(0) = %d != expected 100", val)
+		}
+	})
+
+	t.Run("PutGetDelete", func(t *testing.T) {
+		m := NewHashMap[string, int]()
+
+		for i := range 1000 {
+			m.Put(strconv.Itoa(i), i)
+		}
+		m.Put("7", 70)
+		checkInvariants(t, m)
+
+		if val, _ := m.Get("7"); val != 70 || m.Len() != 1000 {
+			t.Errorf("Replacing a value gave %d with Len %d", val, m.Len())
+		}
+
+		for i := 0; i < 1000; i += 2 {
+			if !m.Delete(strconv.Itoa(i)) {
+				t.Fatalf("Delete(%d) reported missing", i)
+			}
+		}
+		checkInvariants(t, m)
+
+		for i := range 1000 {
+			if m.Contains(strconv.Itoa(i)) != (i%2 == 1) {
+				t.Fatalf("Contains(%d) = %t after deleting evens", i, m.Contains(strconv.Itoa(i)))
+			}
+		}
+
+		if got := maps.Collect(m.All()); len(got) != 500 || got["7"] != 70 {
+			t.Errorf("All collected %d keys with 7 -> %d", len(got), got["7"])
+		}
+
+		m.Clear()
+		if !m.IsEmpty() || m.Contains("1") {
+			t.Error("Map not empty after Clear")
+		}
+	})
+
+	t.Run("Model", func(t *testing.T) {
+		rng := rand.New(rand.NewPCG(1, 2))
+		m := NewHashMap[int, int]()
+		model := map[int]int{}
+
+		for step := range 20000 {
+			key := rng.IntN(500)
+			switch rng.IntN(3) {
+			case 0:
+				m.Put(key, step)
+				model[key] = step
+			case 1:
+				_, ok := model[key]
+				if m.Delete(key) != ok {
+					t.Fatalf("Step %d: Delete(%d) != %t", step, key, ok)
+				}
+				delete(model, key)
+			default:
+				val, ok := m.Get(key)
+				if expected, found := model[key]; ok != found || val != expected {
+					t.Fatalf("Step %d: Get(%d) = %d (%t) != expected %d (%t)", step, key, val, ok, expected, found)
+				}
+			}
+
+			if m.Len() != len(model) {
+				t.Fatalf("Step %d: Len %d != expected %d", step, m.Len(), len(model))
+			}
+		}
+
+		checkInvariants(t, m)
+		if !maps.Equal(maps.Collect(m.All()), model) {
+			t.Error("Contents differ from model")
+		}
+	})
+}
+
+func BenchmarkHashMap(b *testing.B) {
+	const n = 1 << 14
+	keys := rand.New(rand.NewPCG(1, 2)).Perm(n)
+
+	b.Run("Insert/HashMap", func(b *testing.B) {
+		for b.Loop() {
+			m := NewHashMap[int, int]()
+			for _, k := range keys {
+				m.Put(k, k)
+			}
+		}
+	})
+
+	b.Run("Insert/Builtin", func(b *testing.B) {
+		for b.Loop() {
+			m := map[int]int{}
+			for _, k := range keys {
+				m[k] = k
+			}
+		}
+	})
+
+	hm := NewHashMap[int, int]()
+	bm := map[int]int{}
+	for _, k := range keys {
+		hm.Put(k, k)
+		bm[k] = k
+	}
+
+	b.Run("Lookup/HashMap", func(b *testing.B) {
+		for b.Loop() {
+			for _, k := range keys {
+				hm.Get(k)
+				hm.Get(k + n)
+			}
+		}
+	})
+
+	b.Run("Lookup/Builtin", func(b *testing.B) {
+		for b.Loop() {
+			for _, k := range keys {
+				_ = bm[k]
+				_ = bm[k+n]
+			}
+		}
+	})
+
+	// Replace every key in turn, keeping the map at a steady size.
+	b.Run("Delete/HashMap", func(b *testing.B) {
+		for b.Loop() {
+			for _, k := range keys {
+				hm.Delete(k)
+				hm.Put(k, k)
+			}
+		}
+	})
+
+	b.Run("Delete/Builtin", func(b *testing.B) {
+		for b.Loop() {
+			for _, k := range keys {
+				delete(bm, k)
+				bm[k] = k
+			}
+		}
+	})
+}
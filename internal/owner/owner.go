@@ -0,0 +1,29 @@
+// Package owner identifies which container a node belongs to. Merging two
+// containers forwards the owner of the absorbed one to the receiver instead
+// of visiting every node, so lookups follow (and compress) the forwarding
+// chain.
+package owner
+
+// Owner tags the nodes of one container. The zero value is a fresh owner.
+type Owner struct {
+	parent *Owner
+}
+
+// Find returns the owner o currently resolves to.
+func (o *Owner) Find() *Owner {
+	root := o
+	for root.parent != nil {
+		root = root.parent
+	}
+	for o != root {
+		next := o.parent
+		o.parent = root
+		o = next
+	}
+	return root
+}
+
+// Forward makes o, and every node still owned by it, resolve to to.
+func (o *Owner) Forward(to *Owner) {
+	o.parent = to
+}
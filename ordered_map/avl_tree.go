@@ -0,0 +1,144 @@
+package orderedmap
+
+import "cmp"
+
+var _ OrderedMap[int, int] = (*AVLTree[int, int])(nil)
+
+// AVLTree keeps the heights of sibling subtrees within one of each other. It
+// is more rigidly balanced than RedBlackTree, so lookups are slightly faster
+// and updates slightly slower.
+type AVLTree[K, V any] struct {
+	tree[K, V]
+}
+
+func NewAVLTree[K cmp.Ordered, V any]() *AVLTree[K, V] {
+	return NewAVLTreeFunc[K, V](cmp.Compare[K])
+}
+
+func NewAVLTreeFunc[K, V any](compare func(a, b K) int) *AVLTree[K, V] {
+	return &AVLTree[K, V]{tree[K, V]{compare: compare}}
+}
+
+func height[K, V any](n *node[K, V]) int {
+	if n == nil {
+		return 0
+	}
+	return n.height
+}
+
+func updateHeight[K, V any](n *node[K, V]) {
+	n.height = 1 + max(height(n.left), height(n.right))
+}
+
+func avlRotateLeft[K, V any](h *node[K, V]) *node[K, V] {
+	x := h.right
+	h.right = x.left
+	x.left = h
+	updateHeight(h)
+	updateHeight(x)
+	return x
+}
+
+func avlRotateRight[K, V any](h *node[K, V]) *node[K, V] {
+	x := h.left
+	h.left = x.right
+	x.right = h
+	updateHeight(h)
+	updateHeight(x)
+	return x
+}
+
+// Restore the height invariant at h after one of its subtrees changed height
+// by at most one.
+func rebalance[K, V any](h *node[K, V]) *node[K, V] {
+	updateHeight(h)
+
+	switch balance := height(h.left) - height(h.right); {
+	case balance > 1:
+		if height(h.left.left) < height(h.left.right) {
+			h.left = avlRotateLeft(h.left)
+		}
+		return avlRotateRight(h)
+	case balance < -1:
+		if height(h.right.right) < height(h.right.left) {
+			h.right = avlRotateRight(h.right)
+		}
+		return avlRotateLeft(h)
+	}
+
+	return h
+}
+
+func (t *AVLTree[K, V]) put(h *node[K, V], key K, value V) *node[K, V] {
+	if h == nil {
+		t.size++
+		return &node[K, V]{key: key, value: value, height: 1}
+	}
+
+	switch c := t.compare(key, h.key); {
+	case c < 0:
+		h.left = t.put(h.left, key, value)
+	case c > 0:
+		h.right = t.put(h.right, key, value)
+	default:
+		h.value = value
+		return h
+	}
+
+	return rebalance(h)
+}
+
+// Put inserts or replaces the value for key.
+func (t *AVLTree[K, V]) Put(key K, value V) {
+	t.root = t.put(t.root, key, value)
+}
+
+// Detach the least node below h, returning it and the new subtree.
+func avlDeleteMin[K, V any](h *node[K, V]) (*node[K, V], *node[K, V]) {
+	if h.left == nil {
+		return h, h.right
+	}
+	least, left := avlDeleteMin(h.left)
+	h.left = left
+	return least, rebalance(h)
+}
+
+func (t *AVLTree[K, V]) delete(h *node[K, V], key K) (*node[K, V], bool) {
+	if h == nil {
+		return nil, false
+	}
+
+	var found bool
+	switch c := t.compare(key, h.key); {
+	case c < 0:
+		h.left, found = t.delete(h.left, key)
+	case c > 0:
+		h.right, found = t.delete(h.right, key)
+	default:
+		if h.left == nil {
+			return h.right, true
+		}
+		if h.right == nil {
+			return h.left, true
+		}
+		successor, right := avlDeleteMin(h.right)
+		successor.left = h.left
+		successor.right = right
+		return rebalance(successor), true
+	}
+
+	if !found {
+		return h, false
+	}
+	return rebalance(h), true
+}
+
+// Delete removes key and reports whether it was present.
+func (t *AVLTree[K, V]) Delete(key K) bool {
+	var found bool
+	t.root, found = t.delete(t.root, key)
+	if found {
+		t.size--
+	}
+	return found
+}
@@ -0,0 +1,563 @@
+package orderedmap
+
+import (
+	"cmp"
+	"fmt"
+	"iter"
+	"slices"
+)
+
+var _ OrderedMap[int, int] = (*BTree[int, int])(nil)
+
+type BTreeConstraintError struct {
+	Constraint string
+}
+
+func (e *BTreeConstraintError) Error() string {
+	return fmt.Sprintf("Constraint violated: %s", e.Constraint)
+}
+
+func (e *BTreeConstraintError) Is(target error) bool {
+	_, ok := target.(*BTreeConstraintError)
+	return ok
+}
+
+type item[K, V any] struct {
+	key   K
+	value V
+}
+
+// Nodes may be shared between a tree and its clones. Only nodes carrying the
+// token of a tree may be modified by it; others are copied first.
+type cowToken struct {
+	_ int // Distinct allocations of a zero size type may share an address.
+}
+
+type bnode[K, V any] struct {
+	items    []item[K, V]
+	children []*bnode[K, V]
+	cow      *cowToken
+}
+
+func (n *bnode[K, V]) leaf() bool {
+	return len(n.children) == 0
+}
+
+// BTree stores between degree-1 and 2*degree-1 sorted items per node, with
+// every leaf at the same depth. Wide nodes mean fewer pointers to chase and
+// better cache use than a binary tree.
+type BTree[K, V any] struct {
+	root    *bnode[K, V]
+	size    int
+	degree  int
+	compare func(a, b K) int
+	cow     *cowToken
+}
+
+func NewBTree[K cmp.Ordered, V any](degree int) (*BTree[K, V], error) {
+	return NewBTreeFunc[K, V](degree, cmp.Compare[K])
+}
+
+func NewBTreeFunc[K, V any](degree int, compare func(a, b K) int) (*BTree[K, V], error) {
+	if degree < 2 {
+		return nil, &BTreeConstraintError{fmt.Sprintf("Degree %d >= 2", degree)}
+	}
+	return &BTree[K, V]{degree: degree, compare: compare, cow: &cowToken{}}, nil
+}
+
+// NewBTreeFromSorted builds a tree from entries in strictly ascending key
+// order in O(n), without the splits of repeated Put.
+func NewBTreeFromSorted[K, V any](degree int, compare func(a, b K) int, entries iter.Seq2[K, V]) (*BTree[K, V], error) {
+	t, err := NewBTreeFunc[K, V](degree, compare)
+	if err != nil {
+		return nil, err
+	}
+
+	var items []item[K, V]
+	for k, v := range entries {
+		if len(items) > 0 && compare(items[len(items)-1].key, k) >= 0 {
+			return nil, &BTreeConstraintError{"Bulk load keys are strictly ascending"}
+		}
+		items = append(items, item[K, V]{k, v})
+	}
+	if len(items) == 0 {
+		return t, nil
+	}
+
+	// Find the least height fitting every item. A full subtree of height h
+	// holds (2*degree)^(h+1) - 1 items.
+	height, capacity, childCapacity := 0, t.maxItems(), 0
+	for capacity < len(items) {
+		height++
+		childCapacity = capacity
+		capacity = (capacity+1)*2*degree - 1
+	}
+
+	t.root = t.build(items, height, childCapacity, 2)
+	t.size = len(items)
+
+	return t, nil
+}
+
+// Build a subtree of the given height with at least minChildren children.
+// Spreading items evenly over as few children as fit keeps every node at or
+// above the minimum occupancy.
+func (t *BTree[K, V]) build(items []item[K, V], height, childCapacity, minChildren int) *bnode[K, V] {
+	n := &bnode[K, V]{cow: t.cow}
+	if height == 0 {
+		n.items = slices.Clone(items)
+		return n
+	}
+
+	count := max(minChildren, (len(items)+1+childCapacity)/(childCapacity+1))
+	share := (len(items) - count + 1) / count
+	extra := (len(items) - count + 1) % count
+	grandchildCapacity := (childCapacity+1)/(2*t.degree) - 1
+
+	n.items = make([]item[K, V], 0, count-1)
+	n.children = make([]*bnode[K, V], 0, count)
+	for i := range count {
+		size := share
+		if i < extra {
+			size++
+		}
+		n.children = append(n.children, t.build(items[:size], height-1, grandchildCapacity, t.degree))
+		items = items[size:]
+		if i < count-1 {
+			n.items = append(n.items, items[0])
+			items = items[1:]
+		}
+	}
+
+	return n
+}
+
+func (t *BTree[K, V]) maxItems() int {
+	return 2*t.degree - 1
+}
+
+// Clone returns a snapshot of t in O(1). Both trees copy nodes lazily as they
+// are modified, so neither sees changes made to the other.
+func (t *BTree[K, V]) Clone() *BTree[K, V] {
+	clone := *t
+	clone.cow = &cowToken{}
+	t.cow = &cowToken{}
+	return &clone
+}
+
+// Index of the first item with a key not less than key, and whether it is
+// equal.
+func (t *BTree[K, V]) search(n *bnode[K, V], key K) (int, bool) {
+	return slices.BinarySearchFunc(n.items, key, func(it item[K, V], key K) int {
+		return t.compare(it.key, key)
+	})
+}
+
+func (t *BTree[K, V]) mutable(n *bnode[K, V]) *bnode[K, V] {
+	if n.cow == t.cow {
+		return n
+	}
+
+	c := &bnode[K, V]{
+		items: make([]item[K, V], len(n.items), t.maxItems()),
+		cow:   t.cow,
+	}
+	copy(c.items, n.items)
+	if !n.leaf() {
+		c.children = make([]*bnode[K, V], len(n.children), t.maxItems()+1)
+		copy(c.children, n.children)
+	}
+
+	return c
+}
+
+func (t *BTree[K, V]) mutableChild(n *bnode[K, V], i int) *bnode[K, V] {
+	c := t.mutable(n.children[i])
+	n.children[i] = c
+	return c
+}
+
+func (t *BTree[K, V]) find(key K) *item[K, V] {
+	for n := t.root; n != nil; {
+		i, found := t.search(n, key)
+		if found {
+			return &n.items[i]
+		}
+		if n.leaf() {
+			break
+		}
+		n = n.children[i]
+	}
+	return nil
+}
+
+func itemEntry[K, V any](it *item[K, V]) (K, V, bool) {
+	if it == nil {
+		var key K
+		var value V
+		return key, value, false
+	}
+	return it.key, it.value, true
+}
+
+func (t *BTree[K, V]) Get(key K) (V, bool) {
+	_, value, ok := itemEntry(t.find(key))
+	return value, ok
+}
+
+func (t *BTree[K, V]) Contains(key K) bool {
+	return t.find(key) != nil
+}
+
+func (t *BTree[K, V]) Min() (K, V, bool) {
+	if t.root == nil {
+		return itemEntry[K, V](nil)
+	}
+	n := t.root
+	for !n.leaf() {
+		n = n.children[0]
+	}
+	return itemEntry(&n.items[0])
+}
+
+func (t *BTree[K, V]) Max() (K, V, bool) {
+	if t.root == nil {
+		return itemEntry[K, V](nil)
+	}
+	n := t.root
+	for !n.leaf() {
+		n = n.children[len(n.children)-1]
+	}
+	return itemEntry(&n.items[len(n.items)-1])
+}
+
+func (t *BTree[K, V]) Floor(key K) (K, V, bool) {
+	var best *item[K, V]
+	for n := t.root; n != nil; {
+		i, found := t.search(n, key)
+		if found {
+			return itemEntry(&n.items[i])
+		}
+		if i > 0 {
+			best = &n.items[i-1]
+		}
+		if n.leaf() {
+			break
+		}
+		n = n.children[i]
+	}
+	return itemEntry(best)
+}
+
+func (t *BTree[K, V]) Ceiling(key K) (K, V, bool) {
+	var best *item[K, V]
+	for n := t.root; n != nil; {
+		i, found := t.search(n, key)
+		if found {
+			return itemEntry(&n.items[i])
+		}
+		if i < len(n.items) {
+			best = &n.items[i]
+		}
+		if n.leaf() {
+			break
+		}
+		n = n.children[i]
+	}
+	return itemEntry(best)
+}
+
+// Move the median of the full child i of n up into n, splitting the child.
+func (t *BTree[K, V]) splitChild(n *bnode[K, V], i int) {
+	child := n.children[i]
+	mid := t.degree - 1
+
+	right := &bnode[K, V]{
+		items: make([]item[K, V], 0, t.maxItems()),
+		cow:   t.cow,
+	}
+	right.items = append(right.items, child.items[mid+1:]...)
+	if !child.leaf() {
+		right.children = make([]*bnode[K, V], 0, t.maxItems()+1)
+		right.children = append(right.children, child.children[mid+1:]...)
+		clear(child.children[mid+1:])
+		child.children = child.children[:mid+1]
+	}
+
+	median := child.items[mid]
+	clear(child.items[mid:])
+	child.items = child.items[:mid]
+
+	n.items = slices.Insert(n.items, i, median)
+	n.children = slices.Insert(n.children, i+1, right)
+}
+
+// Insert into the subtree at n, which is mutable and not full.
+func (t *BTree[K, V]) insert(n *bnode[K, V], it item[K, V]) {
+	for {
+		i, found := t.search(n, it.key)
+		if found {
+			n.items[i].value = it.value
+			return
+		}
+		if n.leaf() {
+			n.items = slices.Insert(n.items, i, it)
+			t.size++
+			return
+		}
+
+		child := t.mutableChild(n, i)
+		if len(child.items) == t.maxItems() {
+			t.splitChild(n, i)
+			switch c := t.compare(it.key, n.items[i].key); {
+			case c == 0:
+				n.items[i].value = it.value
+				return
+			case c > 0:
+				i++
+			}
+		}
+		n = n.children[i]
+	}
+}
+
+// Put inserts or replaces the value for key.
+func (t *BTree[K, V]) Put(key K, value V) {
+	if t.root == nil {
+		t.root = &bnode[K, V]{items: make([]item[K, V], 0, t.maxItems()), cow: t.cow}
+	}
+
+	t.root = t.mutable(t.root)
+	if len(t.root.items) == t.maxItems() {
+		root := &bnode[K, V]{
+			items:    make([]item[K, V], 0, t.maxItems()),
+			children: make([]*bnode[K, V], 0, t.maxItems()+1),
+			cow:      t.cow,
+		}
+		root.children = append(root.children, t.root)
+		t.splitChild(root, 0)
+		t.root = root
+	}
+
+	t.insert(t.root, item[K, V]{key, value})
+}
+
+// Merge child i+1 and the separating item into child i.
+func (t *BTree[K, V]) merge(n *bnode[K, V], i int) *bnode[K, V] {
+	left := t.mutableChild(n, i)
+	right := n.children[i+1]
+
+	left.items = append(left.items, n.items[i])
+	left.items = append(left.items, right.items...)
+	left.children = append(left.children, right.children...)
+
+	n.items = slices.Delete(n.items, i, i+1)
+	n.children = slices.Delete(n.children, i+1, i+2)
+
+	return left
+}
+
+// Make sure child i of n has more than the minimum number of items before
+// descending into it, borrowing from or merging with a sibling. Returns the
+// mutable child which now covers the keys of the old child i.
+func (t *BTree[K, V]) grow(n *bnode[K, V], i int) *bnode[K, V] {
+	child := t.mutableChild(n, i)
+	if len(child.items) >= t.degree {
+		return child
+	}
+
+	if i > 0 && len(n.children[i-1].items) >= t.degree {
+		left := t.mutableChild(n, i-1)
+		child.items = slices.Insert(child.items, 0, n.items[i-1])
+		n.items[i-1] = left.items[len(left.items)-1]
+		left.items = slices.Delete(left.items, len(left.items)-1, len(left.items))
+		if !left.leaf() {
+			child.children = slices.Insert(child.children, 0, left.children[len(left.children)-1])
+			left.children = slices.Delete(left.children, len(left.children)-1, len(left.children))
+		}
+		return child
+	}
+
+	if i < len(n.items) && len(n.children[i+1].items) >= t.degree {
+		right := t.mutableChild(n, i+1)
+		child.items = append(child.items, n.items[i])
+		n.items[i] = right.items[0]
+		right.items = slices.Delete(right.items, 0, 1)
+		if !right.leaf() {
+			child.children = append(child.children, right.children[0])
+			right.children = slices.Delete(right.children, 0, 1)
+		}
+		return child
+	}
+
+	if i < len(n.items) {
+		return t.merge(n, i)
+	}
+	return t.merge(n, i-1)
+}
+
+// Remove key, which must be present, from the subtree at n, which is
+// mutable and holds more than the minimum number of items unless it is the
+// root.
+func (t *BTree[K, V]) delete(n *bnode[K, V], key K) {
+	for {
+		i, found := t.search(n, key)
+		if n.leaf() {
+			n.items = slices.Delete(n.items, i, i+1)
+			return
+		}
+
+		if !found {
+			n = t.grow(n, i)
+			continue
+		}
+
+		// Replace the item with its predecessor or successor from a child
+		// which can spare one, or merge the children around it.
+		switch {
+		case len(n.children[i].items) >= t.degree:
+			child := t.mutableChild(n, i)
+			pred := child
+			for !pred.leaf() {
+				pred = pred.children[len(pred.children)-1]
+			}
+			n.items[i] = pred.items[len(pred.items)-1]
+			n, key = child, n.items[i].key
+		case len(n.children[i+1].items) >= t.degree:
+			child := t.mutableChild(n, i+1)
+			succ := child
+			for !succ.leaf() {
+				succ = succ.children[0]
+			}
+			n.items[i] = succ.items[0]
+			n, key = child, n.items[i].key
+		default:
+			n = t.merge(n, i)
+		}
+	}
+}
+
+// Delete removes key and reports whether it was present.
+func (t *BTree[K, V]) Delete(key K) bool {
+	if t.find(key) == nil {
+		return false
+	}
+
+	t.root = t.mutable(t.root)
+	t.delete(t.root, key)
+	t.size--
+
+	if len(t.root.items) == 0 {
+		if t.root.leaf() {
+			t.root = nil
+		} else {
+			t.root = t.root.children[0]
+		}
+	}
+
+	return true
+}
+
+func (t *BTree[K, V]) Len() int {
+	return t.size
+}
+
+func (t *BTree[K, V]) IsEmpty() bool {
+	return t.size == 0
+}
+
+func (t *BTree[K, V]) Clear() {
+	t.root = nil
+	t.size = 0
+}
+
+// Visit keys in [lo, hi) in ascending order. Returns false once iteration
+// should stop, either because yield did or a key reached hi.
+func (t *BTree[K, V]) ascendRange(n *bnode[K, V], lo, hi K, yield func(K, V) bool) bool {
+	i, _ := t.search(n, lo)
+	for ; i < len(n.items); i++ {
+		if !n.leaf() && !t.ascendRange(n.children[i], lo, hi, yield) {
+			return false
+		}
+		if t.compare(n.items[i].key, hi) >= 0 || !yield(n.items[i].key, n.items[i].value) {
+			return false
+		}
+	}
+	return n.leaf() || t.ascendRange(n.children[i], lo, hi, yield)
+}
+
+// Visit keys in [lo, hi) in descending order.
+func (t *BTree[K, V]) descendRange(n *bnode[K, V], lo, hi K, yield func(K, V) bool) bool {
+	i, _ := t.search(n, hi)
+	if !n.leaf() && !t.descendRange(n.children[i], lo, hi, yield) {
+		return false
+	}
+	for i--; i >= 0; i-- {
+		if t.compare(n.items[i].key, lo) < 0 || !yield(n.items[i].key, n.items[i].value) {
+			return false
+		}
+		if !n.leaf() && !t.descendRange(n.children[i], lo, hi, yield) {
+			return false
+		}
+	}
+	return true
+}
+
+func (t *BTree[K, V]) ascend(n *bnode[K, V], yield func(K, V) bool) bool {
+	for i, it := range n.items {
+		if !n.leaf() && !t.ascend(n.children[i], yield) {
+			return false
+		}
+		if !yield(it.key, it.value) {
+			return false
+		}
+	}
+	return n.leaf() || t.ascend(n.children[len(n.items)], yield)
+}
+
+func (t *BTree[K, V]) descend(n *bnode[K, V], yield func(K, V) bool) bool {
+	if !n.leaf() && !t.descend(n.children[len(n.items)], yield) {
+		return false
+	}
+	for i := len(n.items) - 1; i >= 0; i-- {
+		if !yield(n.items[i].key, n.items[i].value) {
+			return false
+		}
+		if !n.leaf() && !t.descend(n.children[i], yield) {
+			return false
+		}
+	}
+	return true
+}
+
+func (t *BTree[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		if t.root != nil {
+			t.ascend(t.root, yield)
+		}
+	}
+}
+
+func (t *BTree[K, V]) Backward() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		if t.root != nil {
+			t.descend(t.root, yield)
+		}
+	}
+}
+
+func (t *BTree[K, V]) Range(lo, hi K) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		if t.root != nil {
+			t.ascendRange(t.root, lo, hi, yield)
+		}
+	}
+}
+
+func (t *BTree[K, V]) RangeBackward(lo, hi K) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		if t.root != nil {
+			t.descendRange(t.root, lo, hi, yield)
+		}
+	}
+}
@@ -0,0 +1,216 @@
+package orderedmap
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"iter"
+	"maps"
+	"math/rand/v2"
+	"testing"
+)
+
+// checkBTree verifies search order, node occupancy, and that every leaf is at
+// the same depth.
+func checkBTree[K, V any](t *testing.T, bt *BTree[K, V]) {
+	t.Helper()
+
+	leafDepth := -1
+	var check func(n *bnode[K, V], depth int, lo, hi *K) int
+	check = func(n *bnode[K, V], depth int, lo, hi *K) int {
+		if n != bt.root && len(n.items) < bt.degree-1 || len(n.items) > bt.maxItems() || len(n.items) == 0 {
+			t.Fatalf("Node at depth %d has %d items", depth, len(n.items))
+		}
+		for i, it := range n.items {
+			if lo != nil && bt.compare(it.key, *lo) <= 0 || hi != nil && bt.compare(it.key, *hi) >= 0 {
+				t.Fatalf("Key %v out of search order", it.key)
+			}
+			if i > 0 && bt.compare(n.items[i-1].key, it.key) >= 0 {
+				t.Fatalf("Keys %v and %v out of order", n.items[i-1].key, it.key)
+			}
+		}
+
+		if n.leaf() {
+			if leafDepth >= 0 && depth != leafDepth {
+				t.Fatalf("Leaves at depths %d and %d", leafDepth, depth)
+			}
+			leafDepth = depth
+			return len(n.items)
+		}
+		if len(n.children) != len(n.items)+1 {
+			t.Fatalf("Node has %d items and %d children", len(n.items), len(n.children))
+		}
+
+		count := len(n.items)
+		for i, c := range n.children {
+			childLo, childHi := lo, hi
+			if i > 0 {
+				childLo = &n.items[i-1].key
+			}
+			if i < len(n.items) {
+				childHi = &n.items[i].key
+			}
+			count += check(c, depth+1, childLo, childHi)
+		}
+		return count
+	}
+
+	var count int
+	if bt.root != nil {
+		count = check(bt.root, 0, nil, nil)
+	}
+	if count != bt.size {
+		t.Fatalf("Item count %d != Len %d", count, bt.size)
+	}
+}
+
+func TestBTree(t *testing.T) {
+	if _, err := NewBTree[int, int](1); !errors.Is(err, &BTreeConstraintError{}) {
+		t.Errorf("Got: %v  Expected: BTreeConstraintError.", err)
+	}
+
+	for _, degree := range []int{2, 3, 16} {
+		t.Run(fmt.Sprintf("Degree%d", degree), func(t *testing.T) {
+			bt, _ := NewBTree[int, int](degree)
+			testOrderedMap(t, bt, func(t *testing.T) { checkBTree(t, bt) })
+		})
+	}
+}
+
+func TestBTreeFromSorted(t *testing.T) {
+	for _, degree := range []int{2, 3, 5} {
+		for n := range 300 {
+			entries := func(yield func(int, int) bool) {
+				for i := range n {
+					if !yield(i*2, -i) {
+						return
+					}
+				}
+			}
+
+			bt, err := NewBTreeFromSorted(degree, cmp.Compare[int], entries)
+			if err != nil {
+				t.Fatal(err)
+			}
+			checkBTree(t, bt)
+			if got := maps.Collect(bt.All()); !maps.Equal(got, maps.Collect(entries)) {
+				t.Fatalf("Degree %d size %d: contents differ from input", degree, n)
+			}
+
+			bt.Put(-1, 0)
+			bt.Delete(0)
+			checkBTree(t, bt)
+		}
+	}
+
+	for _, keys := range [][]int{{1, 2, 2}, {3, 2, 1}} {
+		if _, err := NewBTreeFromSorted(2, cmp.Compare[int], pairs(keys)); !errors.Is(err, &BTreeConstraintError{}) {
+			t.Errorf("Keys %v Got: %v  Expected: BTreeConstraintError.", keys, err)
+		}
+	}
+}
+
+func pairs(keys []int) iter.Seq2[int, int] {
+	return func(yield func(int, int) bool) {
+		for _, k := range keys {
+			if !yield(k, k) {
+				return
+			}
+		}
+	}
+}
+
+func TestBTreeClone(t *testing.T) {
+	rng := rand.New(rand.NewPCG(1, 2))
+	bt, _ := NewBTree[int, int](2)
+	model := map[int]int{}
+	for range 500 {
+		k := rng.IntN(1000)
+		bt.Put(k, k)
+		model[k] = k
+	}
+
+	var snapshots []*BTree[int, int]
+	var models []map[int]int
+	for round := range 10 {
+		snapshots = append(snapshots, bt.Clone())
+		models = append(models, maps.Clone(model))
+
+		for range 100 {
+			k := rng.IntN(1000)
+			if rng.IntN(2) == 0 {
+				bt.Put(k, round)
+				model[k] = round
+			} else {
+				bt.Delete(k)
+				delete(model, k)
+			}
+		}
+
+		// Modifying a clone must not affect the original either.
+		scratch := snapshots[len(snapshots)-1].Clone()
+		scratch.Put(-1, -1)
+		for k := range 1000 {
+			scratch.Delete(k)
+		}
+	}
+
+	checkBTree(t, bt)
+	if !maps.Equal(maps.Collect(bt.All()), model) {
+		t.Error("Tree differs from model")
+	}
+	for i, snap := range snapshots {
+		checkBTree(t, snap)
+		if !maps.Equal(maps.Collect(snap.All()), models[i]) {
+			t.Errorf("Snapshot %d changed after Clone", i)
+		}
+	}
+}
+
+func BenchmarkOrderedMap(b *testing.B) {
+	const n = 1 << 14
+	keys := rand.New(rand.NewPCG(1, 2)).Perm(n)
+
+	trees := []struct {
+		name string
+		new  func() OrderedMap[int, int]
+	}{
+		{name: "RedBlackTree", new: func() OrderedMap[int, int] { return NewRedBlackTree[int, int]() }},
+		{name: "AVLTree", new: func() OrderedMap[int, int] { return NewAVLTree[int, int]() }},
+		{name: "BTree/Degree8", new: func() OrderedMap[int, int] { bt, _ := NewBTree[int, int](8); return bt }},
+		{name: "BTree/Degree32", new: func() OrderedMap[int, int] { bt, _ := NewBTree[int, int](32); return bt }},
+	}
+
+	for _, m := range trees {
+		b.Run("Put/"+m.name, func(b *testing.B) {
+			for b.Loop() {
+				om := m.new()
+				for _, k := range keys {
+					om.Put(k, k)
+				}
+			}
+		})
+	}
+
+	for _, m := range trees {
+		om := m.new()
+		for _, k := range keys {
+			om.Put(k, k)
+		}
+
+		b.Run("Get/"+m.name, func(b *testing.B) {
+			for b.Loop() {
+				for _, k := range keys {
+					om.Get(k)
+				}
+			}
+		})
+
+		b.Run("Range/"+m.name, func(b *testing.B) {
+			for b.Loop() {
+				for range om.Range(n/4, 3*n/4) {
+				}
+			}
+		})
+	}
+}
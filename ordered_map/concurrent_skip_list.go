@@ -0,0 +1,261 @@
+package orderedmap
+
+import (
+	"cmp"
+	"iter"
+	"math/rand/v2"
+	"sync"
+	"sync/atomic"
+)
+
+var _ OrderedMap[int, int] = (*ConcurrentSkipList[int, int])(nil)
+
+type cslNode[K, V any] struct {
+	key     K
+	value   atomic.Pointer[V]
+	next    []atomic.Pointer[cslNode[K, V]]
+	deleted atomic.Bool
+}
+
+// ConcurrentSkipList is a skip list safe for concurrent use. Writers take a
+// lock, while readers only load atomic pointers and never block.
+//
+// A node is linked bottom up and unlinked top down, so each level is always
+// a sorted list. Insertion takes effect when the node is linked on the bottom
+// level and deletion when it is marked deleted. Removed nodes keep their
+// links, so a reader standing on one continues to the rest of the list.
+//
+// Iterators are weakly consistent: they reflect some, but not necessarily
+// all, changes made after iteration began. Descending iteration searches
+// again for each key, costing O(log n) per step.
+type ConcurrentSkipList[K, V any] struct {
+	mu      sync.Mutex
+	head    *cslNode[K, V]
+	level   atomic.Int32
+	size    atomic.Int64
+	compare func(a, b K) int
+	rng     *rand.Rand
+}
+
+func NewConcurrentSkipList[K cmp.Ordered, V any]() *ConcurrentSkipList[K, V] {
+	return NewConcurrentSkipListFunc[K, V](cmp.Compare[K], nil)
+}
+
+// NewConcurrentSkipListFunc draws node levels from src, or a randomly seeded
+// source if nil. src is only used by writers while holding the lock.
+func NewConcurrentSkipListFunc[K, V any](compare func(a, b K) int, src rand.Source) *ConcurrentSkipList[K, V] {
+	s := &ConcurrentSkipList[K, V]{
+		head:    &cslNode[K, V]{next: make([]atomic.Pointer[cslNode[K, V]], maxLevel)},
+		compare: compare,
+		rng:     newRand(src),
+	}
+	s.level.Store(1)
+	return s
+}
+
+func (s *ConcurrentSkipList[K, V]) findLess(key K, update *[maxLevel]*cslNode[K, V]) *cslNode[K, V] {
+	x := s.head
+	for i := int(s.level.Load()) - 1; i >= 0; i-- {
+		for next := x.next[i].Load(); next != nil && s.compare(next.key, key) < 0; next = x.next[i].Load() {
+			x = next
+		}
+		if update != nil {
+			update[i] = x
+		}
+	}
+	return x
+}
+
+// Last live node, with a key less than key if bounded.
+func (s *ConcurrentSkipList[K, V]) findLast(key K, bounded bool) *cslNode[K, V] {
+	for {
+		x := s.head
+		for i := int(s.level.Load()) - 1; i >= 0; i-- {
+			for next := x.next[i].Load(); next != nil && (!bounded || s.compare(next.key, key) < 0); next = x.next[i].Load() {
+				x = next
+			}
+		}
+		if x == s.head || !x.deleted.Load() {
+			return s.node(x)
+		}
+		key, bounded = x.key, true
+	}
+}
+
+// First live node at or after n.
+func live[K, V any](n *cslNode[K, V]) *cslNode[K, V] {
+	for n != nil && n.deleted.Load() {
+		n = n.next[0].Load()
+	}
+	return n
+}
+
+func (s *ConcurrentSkipList[K, V]) node(x *cslNode[K, V]) *cslNode[K, V] {
+	if x == s.head {
+		return nil
+	}
+	return x
+}
+
+func cslEntry[K, V any](n *cslNode[K, V]) (K, V, bool) {
+	if n == nil {
+		var key K
+		var value V
+		return key, value, false
+	}
+	return n.key, *n.value.Load(), true
+}
+
+func (s *ConcurrentSkipList[K, V]) find(key K) *cslNode[K, V] {
+	n := s.findLess(key, nil).next[0].Load()
+	if n != nil && s.compare(n.key, key) == 0 && !n.deleted.Load() {
+		return n
+	}
+	return nil
+}
+
+func (s *ConcurrentSkipList[K, V]) Get(key K) (V, bool) {
+	_, value, ok := cslEntry(s.find(key))
+	return value, ok
+}
+
+func (s *ConcurrentSkipList[K, V]) Contains(key K) bool {
+	return s.find(key) != nil
+}
+
+// Put inserts or replaces the value for key.
+func (s *ConcurrentSkipList[K, V]) Put(key K, value V) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var update [maxLevel]*cslNode[K, V]
+	x := s.findLess(key, &update)
+	if n := x.next[0].Load(); n != nil && s.compare(n.key, key) == 0 {
+		n.value.Store(&value)
+		return
+	}
+
+	level := randomLevel(s.rng)
+	for i := int(s.level.Load()); i < level; i++ {
+		update[i] = s.head
+	}
+
+	n := &cslNode[K, V]{key: key, next: make([]atomic.Pointer[cslNode[K, V]], level)}
+	n.value.Store(&value)
+	for i := range level {
+		n.next[i].Store(update[i].next[i].Load())
+	}
+	for i := range level {
+		update[i].next[i].Store(n)
+	}
+	if level > int(s.level.Load()) {
+		s.level.Store(int32(level))
+	}
+	s.size.Add(1)
+}
+
+// Delete removes key and reports whether it was present.
+func (s *ConcurrentSkipList[K, V]) Delete(key K) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var update [maxLevel]*cslNode[K, V]
+	n := s.findLess(key, &update).next[0].Load()
+	if n == nil || s.compare(n.key, key) != 0 {
+		return false
+	}
+
+	n.deleted.Store(true)
+	for i := len(n.next) - 1; i >= 0; i-- {
+		update[i].next[i].Store(n.next[i].Load())
+	}
+	s.size.Add(-1)
+
+	return true
+}
+
+func (s *ConcurrentSkipList[K, V]) Min() (K, V, bool) {
+	return cslEntry(live(s.head.next[0].Load()))
+}
+
+func (s *ConcurrentSkipList[K, V]) Max() (K, V, bool) {
+	var key K
+	return cslEntry(s.findLast(key, false))
+}
+
+func (s *ConcurrentSkipList[K, V]) Floor(key K) (K, V, bool) {
+	if n := s.find(key); n != nil {
+		return cslEntry(n)
+	}
+	return cslEntry(s.findLast(key, true))
+}
+
+func (s *ConcurrentSkipList[K, V]) Ceiling(key K) (K, V, bool) {
+	return cslEntry(live(s.findLess(key, nil).next[0].Load()))
+}
+
+func (s *ConcurrentSkipList[K, V]) Len() int {
+	return int(s.size.Load())
+}
+
+func (s *ConcurrentSkipList[K, V]) IsEmpty() bool {
+	return s.size.Load() == 0
+}
+
+func (s *ConcurrentSkipList[K, V]) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i := range s.head.next {
+		s.head.next[i].Store(nil)
+	}
+	s.level.Store(1)
+	s.size.Store(0)
+}
+
+// Yield live nodes from n onwards while their keys are below hi, if bounded.
+func (s *ConcurrentSkipList[K, V]) ascend(n *cslNode[K, V], hi K, bounded bool, yield func(K, V) bool) {
+	for n = live(n); n != nil && (!bounded || s.compare(n.key, hi) < 0); n = live(n.next[0].Load()) {
+		key, value, _ := cslEntry(n)
+		if !yield(key, value) {
+			return
+		}
+	}
+}
+
+// Yield live nodes from n backwards while their keys are at least lo, if
+// bounded.
+func (s *ConcurrentSkipList[K, V]) descend(n *cslNode[K, V], lo K, bounded bool, yield func(K, V) bool) {
+	for ; n != nil && (!bounded || s.compare(n.key, lo) >= 0); n = s.findLast(n.key, true) {
+		key, value, _ := cslEntry(n)
+		if !yield(key, value) {
+			return
+		}
+	}
+}
+
+func (s *ConcurrentSkipList[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		var hi K
+		s.ascend(s.head.next[0].Load(), hi, false, yield)
+	}
+}
+
+func (s *ConcurrentSkipList[K, V]) Backward() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		var lo K
+		s.descend(s.findLast(lo, false), lo, false, yield)
+	}
+}
+
+func (s *ConcurrentSkipList[K, V]) Range(lo, hi K) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		s.ascend(s.findLess(lo, nil).next[0].Load(), hi, true, yield)
+	}
+}
+
+func (s *ConcurrentSkipList[K, V]) RangeBackward(lo, hi K) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		s.descend(s.findLast(hi, true), lo, true, yield)
+	}
+}
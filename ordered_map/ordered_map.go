@@ -0,0 +1,222 @@
+// OrderedMap
+// Maps keeping their keys sorted using balanced search trees
+
+package orderedmap
+
+import (
+	"iter"
+
+	"github.com/jdavasligil/golang-dsa/abstract/container"
+)
+
+// OrderedMap is implemented by RedBlackTree, AVLTree and BTree. Methods
+// returning a key, value and bool report false when no such key exists.
+type OrderedMap[K, V any] interface {
+	container.Sized
+	container.Clearable
+
+	Get(key K) (V, bool)
+	Put(key K, value V)
+	Delete(key K) bool
+
+	Min() (K, V, bool)
+	Max() (K, V, bool)
+
+	// Floor returns the greatest key less than or equal to key.
+	Floor(key K) (K, V, bool)
+
+	// Ceiling returns the least key greater than or equal to key.
+	Ceiling(key K) (K, V, bool)
+
+	// All iterates in ascending key order.
+	All() iter.Seq2[K, V]
+
+	// Backward iterates in descending key order.
+	Backward() iter.Seq2[K, V]
+
+	// Range iterates keys k with lo <= k < hi in ascending order.
+	Range(lo, hi K) iter.Seq2[K, V]
+
+	// RangeBackward iterates keys k with lo <= k < hi in descending order.
+	RangeBackward(lo, hi K) iter.Seq2[K, V]
+}
+
+type node[K, V any] struct {
+	key         K
+	value       V
+	left, right *node[K, V]
+
+	// Balancing state. Only the field used by the owning tree is kept.
+	red    bool
+	height int
+}
+
+// Search and iteration shared by the balanced trees, which differ only in
+// how they restructure on Put and Delete.
+type tree[K, V any] struct {
+	root    *node[K, V]
+	size    int
+	compare func(a, b K) int
+}
+
+func (t *tree[K, V]) find(key K) *node[K, V] {
+	n := t.root
+	for n != nil {
+		switch c := t.compare(key, n.key); {
+		case c < 0:
+			n = n.left
+		case c > 0:
+			n = n.right
+		default:
+			return n
+		}
+	}
+	return nil
+}
+
+func entry[K, V any](n *node[K, V]) (K, V, bool) {
+	if n == nil {
+		var key K
+		var value V
+		return key, value, false
+	}
+	return n.key, n.value, true
+}
+
+func (t *tree[K, V]) Get(key K) (V, bool) {
+	_, value, ok := entry(t.find(key))
+	return value, ok
+}
+
+func (t *tree[K, V]) Contains(key K) bool {
+	return t.find(key) != nil
+}
+
+func (t *tree[K, V]) Min() (K, V, bool) {
+	n := t.root
+	for n != nil && n.left != nil {
+		n = n.left
+	}
+	return entry(n)
+}
+
+func (t *tree[K, V]) Max() (K, V, bool) {
+	n := t.root
+	for n != nil && n.right != nil {
+		n = n.right
+	}
+	return entry(n)
+}
+
+func (t *tree[K, V]) Floor(key K) (K, V, bool) {
+	var best *node[K, V]
+	for n := t.root; n != nil; {
+		switch c := t.compare(key, n.key); {
+		case c < 0:
+			n = n.left
+		case c > 0:
+			best = n
+			n = n.right
+		default:
+			return entry(n)
+		}
+	}
+	return entry(best)
+}
+
+func (t *tree[K, V]) Ceiling(key K) (K, V, bool) {
+	var best *node[K, V]
+	for n := t.root; n != nil; {
+		switch c := t.compare(key, n.key); {
+		case c < 0:
+			best = n
+			n = n.left
+		case c > 0:
+			n = n.right
+		default:
+			return entry(n)
+		}
+	}
+	return entry(best)
+}
+
+func (t *tree[K, V]) Len() int {
+	return t.size
+}
+
+func (t *tree[K, V]) IsEmpty() bool {
+	return t.size == 0
+}
+
+func (t *tree[K, V]) Clear() {
+	t.root = nil
+	t.size = 0
+}
+
+func ascend[K, V any](n *node[K, V], yield func(K, V) bool) bool {
+	return n == nil || ascend(n.left, yield) && yield(n.key, n.value) && ascend(n.right, yield)
+}
+
+func descend[K, V any](n *node[K, V], yield func(K, V) bool) bool {
+	return n == nil || descend(n.right, yield) && yield(n.key, n.value) && descend(n.left, yield)
+}
+
+// Visit keys in [lo, hi) in ascending order, skipping subtrees outside it.
+func (t *tree[K, V]) ascendRange(n *node[K, V], lo, hi K, yield func(K, V) bool) bool {
+	if n == nil {
+		return true
+	}
+
+	aboveLo := t.compare(lo, n.key) <= 0
+	belowHi := t.compare(n.key, hi) < 0
+
+	if aboveLo && !t.ascendRange(n.left, lo, hi, yield) {
+		return false
+	}
+	if aboveLo && belowHi && !yield(n.key, n.value) {
+		return false
+	}
+	return !belowHi || t.ascendRange(n.right, lo, hi, yield)
+}
+
+// Visit keys in [lo, hi) in descending order.
+func (t *tree[K, V]) descendRange(n *node[K, V], lo, hi K, yield func(K, V) bool) bool {
+	if n == nil {
+		return true
+	}
+
+	aboveLo := t.compare(lo, n.key) <= 0
+	belowHi := t.compare(n.key, hi) < 0
+
+	if belowHi && !t.descendRange(n.right, lo, hi, yield) {
+		return false
+	}
+	if aboveLo && belowHi && !yield(n.key, n.value) {
+		return false
+	}
+	return !aboveLo || t.descendRange(n.left, lo, hi, yield)
+}
+
+func (t *tree[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		ascend(t.root, yield)
+	}
+}
+
+func (t *tree[K, V]) Backward() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		descend(t.root, yield)
+	}
+}
+
+func (t *tree[K, V]) Range(lo, hi K) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		t.ascendRange(t.root, lo, hi, yield)
+	}
+}
+
+func (t *tree[K, V]) RangeBackward(lo, hi K) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		t.descendRange(t.root, lo, hi, yield)
+	}
+}
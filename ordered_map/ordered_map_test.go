@@ -0,0 +1,255 @@
+package orderedmap
+
+import (
+	"cmp"
+	"maps"
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+// Keys of n lie strictly between lo and hi, where nil means unbounded.
+func checkOrder[K, V any](t *testing.T, tr *tree[K, V], n *node[K, V], lo, hi *K) int {
+	t.Helper()
+
+	if n == nil {
+		return 0
+	}
+	if lo != nil && tr.compare(n.key, *lo) <= 0 || hi != nil && tr.compare(n.key, *hi) >= 0 {
+		t.Fatalf("Key %v out of search order", n.key)
+	}
+	return 1 + checkOrder(t, tr, n.left, lo, &n.key) + checkOrder(t, tr, n.right, &n.key, hi)
+}
+
+func checkTree[K, V any](t *testing.T, tr *tree[K, V]) {
+	t.Helper()
+
+	if count := checkOrder(t, tr, tr.root, nil, nil); count != tr.size {
+		t.Fatalf("Node count %d != Len %d", count, tr.size)
+	}
+}
+
+// checkRedBlack verifies search order, that red nodes are left children with
+// black children, and that every path from the root has the same number of
+// black nodes.
+func checkRedBlack[K, V any](t *testing.T, rb *RedBlackTree[K, V]) {
+	t.Helper()
+
+	checkTree(t, &rb.tree)
+	if isRed(rb.root) {
+		t.Fatal("Root is red")
+	}
+
+	var blackHeight func(n *node[K, V]) int
+	blackHeight = func(n *node[K, V]) int {
+		if n == nil {
+			return 0
+		}
+		if isRed(n.right) {
+			t.Fatalf("Key %v has a red right child", n.key)
+		}
+		if isRed(n) && isRed(n.left) {
+			t.Fatalf("Key %v is red with a red child", n.key)
+		}
+		left, right := blackHeight(n.left), blackHeight(n.right)
+		if left != right {
+			t.Fatalf("Key %v has black heights %d and %d", n.key, left, right)
+		}
+		if n.red {
+			return left
+		}
+		return left + 1
+	}
+	blackHeight(rb.root)
+}
+
+// checkAVL verifies search order, stored heights and that sibling heights
+// differ by at most one.
+func checkAVL[K, V any](t *testing.T, avl *AVLTree[K, V]) {
+	t.Helper()
+
+	checkTree(t, &avl.tree)
+
+	var check func(n *node[K, V]) int
+	check = func(n *node[K, V]) int {
+		if n == nil {
+			return 0
+		}
+		left, right := check(n.left), check(n.right)
+		if left-right > 1 || right-left > 1 {
+			t.Fatalf("Key %v has subtree heights %d and %d", n.key, left, right)
+		}
+		if h := 1 + max(left, right); h != n.height {
+			t.Fatalf("Key %v stored height %d != actual %d", n.key, n.height, h)
+		}
+		return n.height
+	}
+	check(avl.root)
+}
+
+func testOrderedMap(t *testing.T, m OrderedMap[int, int], check func(t *testing.T)) {
+	t.Run("Empty", func(t *testing.T) {
+		if _, _, ok := m.Min(); ok {
+			t.Error("Min reported a key")
+		}
+		if _, _, ok := m.Floor(0); ok {
+			t.Error("Floor reported a key")
+		}
+		if m.Delete(0) {
+			t.Error("Delete reported a key")
+		}
+	})
+
+	t.Run("Queries", func(t *testing.T) {
+		for _, k := range []int{50, 20, 80, 10, 30, 70, 90} {
+			m.Put(k, -k)
+		}
+		check(t)
+
+		tests := []struct {
+			name     string
+			query    func(int) (int, int, bool)
+			key      int
+			expected int
+			ok       bool
+		}{
+			{name: "Floor", query: m.Floor, key: 55, expected: 50, ok: true},
+			{name: "Floor", query: m.Floor, key: 30, expected: 30, ok: true},
+			{name: "Floor", query: m.Floor, key: 5},
+			{name: "Ceiling", query: m.Ceiling, key: 55, expected: 70, ok: true},
+			{name: "Ceiling", query: m.Ceiling, key: 90, expected: 90, ok: true},
+			{name: "Ceiling", query: m.Ceiling, key: 95},
+		}
+		for _, tt := range tests {
+			key, value, ok := tt.query(tt.key)
+			if ok != tt.ok || ok && (key != tt.expected || value != -tt.expected) {
+				t.Errorf("%s(%d) = %d, %d (%t) != expected %d (%t)", tt.name, tt.key, key, value, ok, tt.expected, tt.ok)
+			}
+		}
+
+		if k, _, _ := m.Min(); k != 10 {
+			t.Errorf("Min %d != expected 10", k)
+		}
+		if k, _, _ := m.Max(); k != 90 {
+			t.Errorf("Max %d != expected 90", k)
+		}
+
+		var keys []int
+		for k := range m.Range(20, 80) {
+			keys = append(keys, k)
+		}
+		if !slices.Equal(keys, []int{20, 30, 50, 70}) {
+			t.Errorf("Range(20, 80) = %v != expected [20 30 50 70]", keys)
+		}
+
+		var backward []int
+		for k := range m.Backward() {
+			backward = append(backward, k)
+			if k == 50 {
+				break
+			}
+		}
+		if !slices.Equal(backward, []int{90, 80, 70, 50}) {
+			t.Errorf("Backward with break = %v != expected [90 80 70 50]", backward)
+		}
+
+		m.Clear()
+		if !m.IsEmpty() {
+			t.Error("Map not empty after Clear")
+		}
+	})
+
+	t.Run("Model", func(t *testing.T) {
+		rng := rand.New(rand.NewPCG(1, 2))
+		model := map[int]int{}
+
+		for step := range 5000 {
+			key := rng.IntN(1000)
+			switch rng.IntN(3) {
+			case 0, 1:
+				m.Put(key, step)
+				model[key] = step
+			default:
+				_, ok := model[key]
+				if m.Delete(key) != ok {
+					t.Fatalf("Step %d: Delete(%d) != %t", step, key, ok)
+				}
+				delete(model, key)
+			}
+			if step%100 == 0 {
+				check(t)
+			}
+		}
+		check(t)
+
+		keys := slices.Sorted(maps.Keys(model))
+		var got []int
+		for k, v := range m.All() {
+			if v != model[k] {
+				t.Fatalf("Key %d value %d != expected %d", k, v, model[k])
+			}
+			got = append(got, k)
+		}
+		if !slices.Equal(got, keys) {
+			t.Fatal("All did not yield the model keys in order")
+		}
+
+		for range 100 {
+			lo := rng.IntN(1000)
+			hi := lo + rng.IntN(200)
+			var expected []int
+			for _, k := range keys {
+				if lo <= k && k < hi {
+					expected = append(expected, k)
+				}
+			}
+			var got []int
+			for k := range m.Range(lo, hi) {
+				got = append(got, k)
+			}
+			if !slices.Equal(got, expected) {
+				t.Fatalf("Range(%d, %d) = %v != expected %v", lo, hi, got, expected)
+			}
+
+			got = got[:0]
+			for k := range m.RangeBackward(lo, hi) {
+				got = append(got, k)
+			}
+			slices.Reverse(expected)
+			if !slices.Equal(got, expected) {
+				t.Fatalf("RangeBackward(%d, %d) = %v != expected %v", lo, hi, got, expected)
+			}
+
+			i, found := slices.BinarySearch(keys, lo)
+			k, _, ok := m.Ceiling(lo)
+			if ok != (i < len(keys)) || ok && k != keys[i] {
+				t.Fatalf("Ceiling(%d) = %d (%t)", lo, k, ok)
+			}
+			if !found {
+				i--
+			}
+			k, _, ok = m.Floor(lo)
+			if ok != (i >= 0) || ok && k != keys[i] {
+				t.Fatalf("Floor(%d) = %d (%t)", lo, k, ok)
+			}
+		}
+
+		for _, k := range keys {
+			m.Delete(k)
+		}
+		check(t)
+		if !m.IsEmpty() {
+			t.Errorf("Len %d after deleting every key", m.Len())
+		}
+	})
+}
+
+func TestRedBlackTree(t *testing.T) {
+	rb := NewRedBlackTree[int, int]()
+	testOrderedMap(t, rb, func(t *testing.T) { checkRedBlack(t, rb) })
+}
+
+func TestAVLTree(t *testing.T) {
+	avl := NewAVLTreeFunc[int, int](cmp.Compare[int])
+	testOrderedMap(t, avl, func(t *testing.T) { checkAVL(t, avl) })
+}
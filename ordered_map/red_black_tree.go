@@ -0,0 +1,174 @@
+package orderedmap
+
+import "cmp"
+
+var _ OrderedMap[int, int] = (*RedBlackTree[int, int])(nil)
+
+// RedBlackTree is a left-leaning red-black tree: a binary encoding of a 2-3
+// tree where a red node is glued to its parent, and only left children may
+// be red.
+type RedBlackTree[K, V any] struct {
+	tree[K, V]
+}
+
+func NewRedBlackTree[K cmp.Ordered, V any]() *RedBlackTree[K, V] {
+	return NewRedBlackTreeFunc[K, V](cmp.Compare[K])
+}
+
+func NewRedBlackTreeFunc[K, V any](compare func(a, b K) int) *RedBlackTree[K, V] {
+	return &RedBlackTree[K, V]{tree[K, V]{compare: compare}}
+}
+
+func isRed[K, V any](n *node[K, V]) bool {
+	return n != nil && n.red
+}
+
+func rotateLeft[K, V any](h *node[K, V]) *node[K, V] {
+	x := h.right
+	h.right = x.left
+	x.left = h
+	x.red = h.red
+	h.red = true
+	return x
+}
+
+func rotateRight[K, V any](h *node[K, V]) *node[K, V] {
+	x := h.left
+	h.left = x.right
+	x.right = h
+	x.red = h.red
+	h.red = true
+	return x
+}
+
+// Split or merge a temporary 4-node.
+func flipColors[K, V any](h *node[K, V]) {
+	h.red = !h.red
+	h.left.red = !h.left.red
+	h.right.red = !h.right.red
+}
+
+// Restore left-leaning form on the way back up.
+func fixUp[K, V any](h *node[K, V]) *node[K, V] {
+	if isRed(h.right) && !isRed(h.left) {
+		h = rotateLeft(h)
+	}
+	if isRed(h.left) && isRed(h.left.left) {
+		h = rotateRight(h)
+	}
+	if isRed(h.left) && isRed(h.right) {
+		flipColors(h)
+	}
+	return h
+}
+
+// Make h.left or one of its children red, assuming h is red and both h.left
+// and h.left.left are black.
+func moveRedLeft[K, V any](h *node[K, V]) *node[K, V] {
+	flipColors(h)
+	if isRed(h.right.left) {
+		h.right = rotateRight(h.right)
+		h = rotateLeft(h)
+		flipColors(h)
+	}
+	return h
+}
+
+// Make h.right or one of its children red, assuming h is red and both
+// h.right and h.right.left are black.
+func moveRedRight[K, V any](h *node[K, V]) *node[K, V] {
+	flipColors(h)
+	if isRed(h.left.left) {
+		h = rotateRight(h)
+		flipColors(h)
+	}
+	return h
+}
+
+func (t *RedBlackTree[K, V]) put(h *node[K, V], key K, value V) *node[K, V] {
+	if h == nil {
+		t.size++
+		return &node[K, V]{key: key, value: value, red: true}
+	}
+
+	switch c := t.compare(key, h.key); {
+	case c < 0:
+		h.left = t.put(h.left, key, value)
+	case c > 0:
+		h.right = t.put(h.right, key, value)
+	default:
+		h.value = value
+	}
+
+	return fixUp(h)
+}
+
+// Put inserts or replaces the value for key.
+func (t *RedBlackTree[K, V]) Put(key K, value V) {
+	t.root = t.put(t.root, key, value)
+	t.root.red = false
+}
+
+func deleteMin[K, V any](h *node[K, V]) *node[K, V] {
+	if h.left == nil {
+		return nil
+	}
+	if !isRed(h.left) && !isRed(h.left.left) {
+		h = moveRedLeft(h)
+	}
+	h.left = deleteMin(h.left)
+	return fixUp(h)
+}
+
+// Remove key, which must be present, pushing red links down the search path
+// so the node removed at the bottom is never a lone black node.
+func (t *RedBlackTree[K, V]) delete(h *node[K, V], key K) *node[K, V] {
+	if t.compare(key, h.key) < 0 {
+		if !isRed(h.left) && !isRed(h.left.left) {
+			h = moveRedLeft(h)
+		}
+		h.left = t.delete(h.left, key)
+		return fixUp(h)
+	}
+
+	if isRed(h.left) {
+		h = rotateRight(h)
+	}
+	if t.compare(key, h.key) == 0 && h.right == nil {
+		return nil
+	}
+	if !isRed(h.right) && !isRed(h.right.left) {
+		h = moveRedRight(h)
+	}
+	if t.compare(key, h.key) == 0 {
+		successor := h.right
+		for successor.left != nil {
+			successor = successor.left
+		}
+		h.key = successor.key
+		h.value = successor.value
+		h.right = deleteMin(h.right)
+	} else {
+		h.right = t.delete(h.right, key)
+	}
+
+	return fixUp(h)
+}
+
+// Delete removes key and reports whether it was present.
+func (t *RedBlackTree[K, V]) Delete(key K) bool {
+	if t.find(key) == nil {
+		return false
+	}
+
+	if !isRed(t.root.left) && !isRed(t.root.right) {
+		t.root.red = true
+	}
+	t.root = t.delete(t.root, key)
+	if t.root != nil {
+		t.root.red = false
+	}
+	t.size--
+
+	return true
+}
@@ -0,0 +1,233 @@
+package orderedmap
+
+import (
+	"cmp"
+	"iter"
+	"math/rand/v2"
+)
+
+var _ OrderedMap[int, int] = (*SkipList[int, int])(nil)
+
+// Levels are promoted with probability 1/4, so 32 levels cover far more keys
+// than fit in memory.
+const maxLevel = 32
+
+func randomLevel(rng *rand.Rand) int {
+	level := 1
+	for level < maxLevel && rng.Uint64()&3 == 0 {
+		level++
+	}
+	return level
+}
+
+func newRand(src rand.Source) *rand.Rand {
+	if src == nil {
+		src = rand.NewPCG(rand.Uint64(), rand.Uint64())
+	}
+	return rand.New(src)
+}
+
+type slNode[K, V any] struct {
+	key   K
+	value V
+	next  []*slNode[K, V]
+	prev  *slNode[K, V]
+}
+
+// SkipList is a sorted linked list with express lanes: each node is also
+// linked on a random number of higher levels, each skipping about four times
+// as far as the one below.
+type SkipList[K, V any] struct {
+	head    slNode[K, V]
+	tail    *slNode[K, V]
+	level   int
+	size    int
+	compare func(a, b K) int
+	rng     *rand.Rand
+}
+
+func NewSkipList[K cmp.Ordered, V any]() *SkipList[K, V] {
+	return NewSkipListFunc[K, V](cmp.Compare[K], nil)
+}
+
+// NewSkipListFunc draws node levels from src, or a randomly seeded source if
+// nil. A fixed seed makes the structure reproducible.
+func NewSkipListFunc[K, V any](compare func(a, b K) int, src rand.Source) *SkipList[K, V] {
+	s := &SkipList[K, V]{compare: compare, rng: newRand(src), level: 1}
+	s.head.next = make([]*slNode[K, V], maxLevel)
+	return s
+}
+
+// Last node with a key less than key, or the head. Records the last node
+// visited on each level in update if not nil.
+func (s *SkipList[K, V]) findLess(key K, update *[maxLevel]*slNode[K, V]) *slNode[K, V] {
+	x := &s.head
+	for i := s.level - 1; i >= 0; i-- {
+		for next := x.next[i]; next != nil && s.compare(next.key, key) < 0; next = x.next[i] {
+			x = next
+		}
+		if update != nil {
+			update[i] = x
+		}
+	}
+	return x
+}
+
+// Nil in place of the head.
+func (s *SkipList[K, V]) node(x *slNode[K, V]) *slNode[K, V] {
+	if x == &s.head {
+		return nil
+	}
+	return x
+}
+
+func slEntry[K, V any](n *slNode[K, V]) (K, V, bool) {
+	if n == nil {
+		var key K
+		var value V
+		return key, value, false
+	}
+	return n.key, n.value, true
+}
+
+func (s *SkipList[K, V]) find(key K) *slNode[K, V] {
+	if n := s.findLess(key, nil).next[0]; n != nil && s.compare(n.key, key) == 0 {
+		return n
+	}
+	return nil
+}
+
+func (s *SkipList[K, V]) Get(key K) (V, bool) {
+	_, value, ok := slEntry(s.find(key))
+	return value, ok
+}
+
+func (s *SkipList[K, V]) Contains(key K) bool {
+	return s.find(key) != nil
+}
+
+// Put inserts or replaces the value for key.
+func (s *SkipList[K, V]) Put(key K, value V) {
+	var update [maxLevel]*slNode[K, V]
+	x := s.findLess(key, &update)
+	if n := x.next[0]; n != nil && s.compare(n.key, key) == 0 {
+		n.value = value
+		return
+	}
+
+	level := randomLevel(s.rng)
+	for ; s.level < level; s.level++ {
+		update[s.level] = &s.head
+	}
+
+	n := &slNode[K, V]{key: key, value: value, next: make([]*slNode[K, V], level), prev: s.node(x)}
+	for i := range level {
+		n.next[i] = update[i].next[i]
+		update[i].next[i] = n
+	}
+	if n.next[0] != nil {
+		n.next[0].prev = n
+	} else {
+		s.tail = n
+	}
+	s.size++
+}
+
+// Delete removes key and reports whether it was present.
+func (s *SkipList[K, V]) Delete(key K) bool {
+	var update [maxLevel]*slNode[K, V]
+	n := s.findLess(key, &update).next[0]
+	if n == nil || s.compare(n.key, key) != 0 {
+		return false
+	}
+
+	for i := range n.next {
+		update[i].next[i] = n.next[i]
+	}
+	if n.next[0] != nil {
+		n.next[0].prev = n.prev
+	} else {
+		s.tail = n.prev
+	}
+	for s.level > 1 && s.head.next[s.level-1] == nil {
+		s.level--
+	}
+	s.size--
+
+	return true
+}
+
+func (s *SkipList[K, V]) Min() (K, V, bool) {
+	return slEntry(s.head.next[0])
+}
+
+func (s *SkipList[K, V]) Max() (K, V, bool) {
+	return slEntry(s.tail)
+}
+
+func (s *SkipList[K, V]) Floor(key K) (K, V, bool) {
+	x := s.findLess(key, nil)
+	if n := x.next[0]; n != nil && s.compare(n.key, key) == 0 {
+		return slEntry(n)
+	}
+	return slEntry(s.node(x))
+}
+
+func (s *SkipList[K, V]) Ceiling(key K) (K, V, bool) {
+	return slEntry(s.findLess(key, nil).next[0])
+}
+
+func (s *SkipList[K, V]) Len() int {
+	return s.size
+}
+
+func (s *SkipList[K, V]) IsEmpty() bool {
+	return s.size == 0
+}
+
+func (s *SkipList[K, V]) Clear() {
+	clear(s.head.next)
+	s.tail = nil
+	s.level = 1
+	s.size = 0
+}
+
+func (s *SkipList[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for n := s.head.next[0]; n != nil; n = n.next[0] {
+			if !yield(n.key, n.value) {
+				return
+			}
+		}
+	}
+}
+
+func (s *SkipList[K, V]) Backward() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for n := s.tail; n != nil; n = n.prev {
+			if !yield(n.key, n.value) {
+				return
+			}
+		}
+	}
+}
+
+func (s *SkipList[K, V]) Range(lo, hi K) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for n := s.findLess(lo, nil).next[0]; n != nil && s.compare(n.key, hi) < 0; n = n.next[0] {
+			if !yield(n.key, n.value) {
+				return
+			}
+		}
+	}
+}
+
+func (s *SkipList[K, V]) RangeBackward(lo, hi K) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for n := s.node(s.findLess(hi, nil)); n != nil && s.compare(n.key, lo) >= 0; n = n.prev {
+			if !yield(n.key, n.value) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,245 @@
+package orderedmap
+
+import (
+	"cmp"
+	"math/rand/v2"
+	"slices"
+	"sync"
+	"testing"
+)
+
+// checkSkipList verifies that every level is sorted and contained in the
+// level below, and that the bottom level's back links and tail match.
+func checkSkipList[K, V any](t *testing.T, s *SkipList[K, V]) {
+	t.Helper()
+
+	var below map[*slNode[K, V]]bool
+	for i := range maxLevel {
+		if i >= s.level && s.head.next[i] != nil {
+			t.Fatalf("Level %d in use above list level %d", i, s.level)
+		}
+
+		level := map[*slNode[K, V]]bool{}
+		var prev *slNode[K, V]
+		for n := s.head.next[i]; n != nil; n = n.next[i] {
+			if prev != nil && s.compare(prev.key, n.key) >= 0 {
+				t.Fatalf("Level %d keys %v and %v out of order", i, prev.key, n.key)
+			}
+			if i > 0 && !below[n] {
+				t.Fatalf("Key %v on level %d but not below", n.key, i)
+			}
+			if i == 0 && n.prev != prev {
+				t.Fatalf("Key %v has the wrong back link", n.key)
+			}
+			level[n] = true
+			prev = n
+		}
+
+		if i == 0 {
+			if prev != s.tail {
+				t.Fatal("Tail is not the last node")
+			}
+			if len(level) != s.size {
+				t.Fatalf("Node count %d != Len %d", len(level), s.size)
+			}
+		}
+		below = level
+	}
+}
+
+func checkConcurrentSkipList[K, V any](t *testing.T, s *ConcurrentSkipList[K, V]) {
+	t.Helper()
+
+	for i := range maxLevel {
+		var prev *cslNode[K, V]
+		var count int
+		for n := s.head.next[i].Load(); n != nil; n = n.next[i].Load() {
+			if n.deleted.Load() {
+				t.Fatalf("Deleted key %v reachable on level %d", n.key, i)
+			}
+			if prev != nil && s.compare(prev.key, n.key) >= 0 {
+				t.Fatalf("Level %d keys %v and %v out of order", i, prev.key, n.key)
+			}
+			prev = n
+			count++
+		}
+		if i == 0 && count != s.Len() {
+			t.Fatalf("Node count %d != Len %d", count, s.Len())
+		}
+	}
+}
+
+func TestSkipList(t *testing.T) {
+	s := NewSkipListFunc[int, int](cmp.Compare[int], rand.NewPCG(1, 2))
+	testOrderedMap(t, s, func(t *testing.T) { checkSkipList(t, s) })
+}
+
+func TestSkipListSeed(t *testing.T) {
+	levels := func() []int {
+		s := NewSkipListFunc[int, int](cmp.Compare[int], rand.NewPCG(1, 2))
+		for k := range 1000 {
+			s.Put(k, k)
+		}
+		var out []int
+		for n := s.head.next[0]; n != nil; n = n.next[0] {
+			out = append(out, len(n.next))
+		}
+		return out
+	}
+
+	if !slices.Equal(levels(), levels()) {
+		t.Error("Skip lists with the same seed have different levels")
+	}
+}
+
+func TestConcurrentSkipList(t *testing.T) {
+	s := NewConcurrentSkipListFunc[int, int](cmp.Compare[int], rand.NewPCG(1, 2))
+	testOrderedMap(t, s, func(t *testing.T) { checkConcurrentSkipList(t, s) })
+
+	for k := range 1000 {
+		s.Put(k, k)
+	}
+	s.Clear()
+	if level := s.level.Load(); level != 1 {
+		t.Errorf("Level %d after Clear != expected 1", level)
+	}
+}
+
+// Readers run alongside a writer. Even keys are never deleted, so readers
+// must always find them. Odd keys come and go.
+func TestConcurrentSkipListReaders(t *testing.T) {
+	const keys = 512
+	s := NewConcurrentSkipList[int, int]()
+	for k := 0; k < keys; k += 2 {
+		s.Put(k, k)
+	}
+
+	done := make(chan struct{})
+	var wg sync.WaitGroup
+
+	for r := range 4 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			rng := rand.New(rand.NewPCG(uint64(r), 0))
+			for {
+				select {
+				case <-done:
+					return
+				default:
+				}
+
+				k := rng.IntN(keys/2) * 2
+				if val, ok := s.Get(k); !ok || val != k {
+					t.Errorf("Get(%d) = %d (%t) during writes", k, val, ok)
+					return
+				}
+
+				prev, evens := -1, 0
+				for k := range s.Range(0, keys) {
+					if k <= prev {
+						t.Errorf("Range yielded %d after %d", k, prev)
+						return
+					}
+					if k%2 == 0 {
+						evens++
+					}
+					prev = k
+				}
+				if evens != keys/2 {
+					t.Errorf("Range yielded %d of %d even keys", evens, keys/2)
+					return
+				}
+
+				prev = keys
+				for k := range s.Backward() {
+					if k >= prev {
+						t.Errorf("Backward yielded %d after %d", k, prev)
+						return
+					}
+					prev = k
+				}
+			}
+		}()
+	}
+
+	rng := rand.New(rand.NewPCG(3, 4))
+	for i := range 20000 {
+		k := rng.IntN(keys/2)*2 + 1
+		if rng.IntN(2) == 0 {
+			s.Put(k, i)
+		} else {
+			s.Delete(k)
+		}
+		if i%1000 == 0 {
+			s.Put(k-1, k-1)
+		}
+	}
+
+	close(done)
+	wg.Wait()
+	checkConcurrentSkipList(t, s)
+}
+
+// lockedSkipList is the alternative to ConcurrentSkipList: a plain skip list
+// behind a read-write lock.
+type lockedSkipList struct {
+	mu sync.RWMutex
+	s  *SkipList[int, int]
+}
+
+func (l *lockedSkipList) Get(k int) (int, bool) {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return l.s.Get(k)
+}
+
+func (l *lockedSkipList) Put(k, v int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.s.Put(k, v)
+}
+
+func BenchmarkSkipListReadWrite(b *testing.B) {
+	const keys = 1 << 14
+
+	c := NewConcurrentSkipList[int, int]()
+	l := &lockedSkipList{s: NewSkipList[int, int]()}
+
+	maps := []struct {
+		name string
+		get  func(int) (int, bool)
+		put  func(int, int)
+	}{
+		{name: "ConcurrentSkipList", get: c.Get, put: c.Put},
+		{name: "RWMutexSkipList", get: l.Get, put: l.Put},
+	}
+
+	for _, m := range maps {
+		for k := range keys {
+			m.put(k, k)
+		}
+
+		b.Run(m.name, func(b *testing.B) {
+			done := make(chan struct{})
+			go func() {
+				for i := 0; ; i++ {
+					select {
+					case <-done:
+						return
+					default:
+						m.put(i%keys, i)
+					}
+				}
+			}()
+
+			b.RunParallel(func(pb *testing.PB) {
+				rng := rand.New(rand.NewPCG(rand.Uint64(), 0))
+				for pb.Next() {
+					m.get(rng.IntN(keys))
+				}
+			})
+			close(done)
+		})
+	}
+}
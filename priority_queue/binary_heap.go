@@ -0,0 +1,165 @@
+// Priority queues ordered by a less function rather than insertion order.
+
+package priority_queue
+
+import (
+	"cmp"
+	"iter"
+	"slices"
+
+	"github.com/jdavasligil/golang-dsa/abstract/container"
+	"github.com/jdavasligil/golang-dsa/abstract/queue"
+)
+
+var (
+	_ queue.Queue[int]        = (*BinaryHeap[int])(nil)
+	_ container.Sized         = (*BinaryHeap[int])(nil)
+	_ container.Clearable     = (*BinaryHeap[int])(nil)
+	_ container.Iterable[int] = (*BinaryHeap[int])(nil)
+)
+
+var (
+	errBinaryHeapDequeueEmpty = &container.EmptyError{Container: "BinaryHeap", Op: "dequeue"}
+	errBinaryHeapEmpty        = &container.EmptyError{Container: "BinaryHeap"}
+)
+
+// BinaryHeap is an array backed priority queue. Dequeue returns the element
+// for which less is true against every other element.
+type BinaryHeap[T any] struct {
+	data []T
+	less func(a, b T) bool
+}
+
+func NewBinaryHeap[T any](less func(a, b T) bool) *BinaryHeap[T] {
+	return &BinaryHeap[T]{less: less}
+}
+
+// NewMinHeap dequeues the smallest element first.
+func NewMinHeap[T cmp.Ordered]() *BinaryHeap[T] {
+	return NewBinaryHeap(cmp.Less[T])
+}
+
+// NewMaxHeap dequeues the largest element first.
+func NewMaxHeap[T cmp.Ordered]() *BinaryHeap[T] {
+	return NewBinaryHeap(func(a, b T) bool { return cmp.Less(b, a) })
+}
+
+// Heapify builds a heap from data in O(n). The heap takes ownership of data.
+func Heapify[T any](data []T, less func(a, b T) bool) *BinaryHeap[T] {
+	h := &BinaryHeap[T]{data: data, less: less}
+	for i := len(data)/2 - 1; i >= 0; i-- {
+		h.down(i)
+	}
+	return h
+}
+
+func (h *BinaryHeap[T]) up(i int) {
+	for i > 0 {
+		parent := (i - 1) / 2
+		if !h.less(h.data[i], h.data[parent]) {
+			return
+		}
+		h.data[i], h.data[parent] = h.data[parent], h.data[i]
+		i = parent
+	}
+}
+
+func (h *BinaryHeap[T]) down(i int) {
+	n := len(h.data)
+	for {
+		smallest := i
+		if l := 2*i + 1; l < n && h.less(h.data[l], h.data[smallest]) {
+			smallest = l
+		}
+		if r := 2*i + 2; r < n && h.less(h.data[r], h.data[smallest]) {
+			smallest = r
+		}
+		if smallest == i {
+			return
+		}
+		h.data[i], h.data[smallest] = h.data[smallest], h.data[i]
+		i = smallest
+	}
+}
+
+// Enqueue never fails. The error is for conformance with queue.Queue.
+func (h *BinaryHeap[T]) Enqueue(element T) error {
+	h.data = append(h.data, element)
+	h.up(len(h.data) - 1)
+	return nil
+}
+
+func (h *BinaryHeap[T]) Dequeue() (T, error) {
+	var result T
+
+	if len(h.data) == 0 {
+		return result, errBinaryHeapDequeueEmpty
+	}
+
+	result = h.data[0]
+	last := len(h.data) - 1
+	h.data[0] = h.data[last]
+	h.data[last] = *new(T)
+	h.data = h.data[:last]
+	h.down(0)
+
+	return result, nil
+}
+
+func (h *BinaryHeap[T]) Peek() (T, error) {
+	var result T
+
+	if len(h.data) == 0 {
+		return result, errBinaryHeapEmpty
+	}
+
+	return h.data[0], nil
+}
+
+// PushPop enqueues element then dequeues, in a single sift. The result is
+// element itself if it would be dequeued first.
+func (h *BinaryHeap[T]) PushPop(element T) T {
+	if len(h.data) == 0 || !h.less(h.data[0], element) {
+		return element
+	}
+
+	result := h.data[0]
+	h.data[0] = element
+	h.down(0)
+
+	return result
+}
+
+// Replace dequeues then enqueues element, in a single sift. Unlike PushPop
+// the result is never element.
+func (h *BinaryHeap[T]) Replace(element T) (T, error) {
+	var result T
+
+	if len(h.data) == 0 {
+		return result, errBinaryHeapDequeueEmpty
+	}
+
+	result = h.data[0]
+	h.data[0] = element
+	h.down(0)
+
+	return result, nil
+}
+
+func (h *BinaryHeap[T]) Len() int {
+	return len(h.data)
+}
+
+func (h *BinaryHeap[T]) IsEmpty() bool {
+	return len(h.data) == 0
+}
+
+func (h *BinaryHeap[T]) Clear() {
+	clear(h.data)
+	h.data = h.data[:0]
+}
+
+// All iterates in heap order, which is not sorted.
+func (h *BinaryHeap[T]) All() iter.Seq[T] {
+	return slices.Values(h.data)
+}
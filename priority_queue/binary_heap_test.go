@@ -0,0 +1,189 @@
+package priority_queue
+
+import (
+	"container/heap"
+	"errors"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/jdavasligil/golang-dsa/abstract/container"
+)
+
+func drain[T any](h *BinaryHeap[T]) []T {
+	var out []T
+	for !h.IsEmpty() {
+		val, _ := h.Dequeue()
+		out = append(out, val)
+	}
+	return out
+}
+
+func TestBinaryHeap(t *testing.T) {
+	t.Run("Empty", func(t *testing.T) {
+		h := NewMinHeap[int]()
+
+		if _, err := h.Dequeue(); !errors.Is(err, &container.EmptyError{}) {
+			t.Errorf("Dequeue Got: %v  Expected: container.EmptyError.", err)
+		}
+		if _, err := h.Peek(); !errors.Is(err, &container.EmptyError{}) {
+			t.Errorf("Peek Got: %v  Expected: container.EmptyError.", err)
+		}
+		if _, err := h.Replace(1); !errors.Is(err, &container.EmptyError{}) {
+			t.Errorf("Replace Got: %v  Expected: container.EmptyError.", err)
+		}
+	})
+
+	t.Run("Ordering", func(t *testing.T) {
+		input := []int{5, 3, 8, 1, 9, 2, 7, 3}
+		expected := slices.Sorted(slices.Values(input))
+
+		minHeap := NewMinHeap[int]()
+		maxHeap := NewMaxHeap[int]()
+		for _, val := range input {
+			minHeap.Enqueue(val)
+			maxHeap.Enqueue(val)
+		}
+
+		if top, _ := minHeap.Peek(); top != 1 {
+			t.Errorf("Min Peek %d != expected 1", top)
+		}
+		if got := drain(minHeap); !slices.Equal(got, expected) {
+			t.Errorf("Min heap order %v != expected %v", got, expected)
+		}
+
+		slices.Reverse(expected)
+		if got := drain(maxHeap); !slices.Equal(got, expected) {
+			t.Errorf("Max heap order %v != expected %v", got, expected)
+		}
+	})
+
+	t.Run("Heapify", func(t *testing.T) {
+		input := rand.Perm(1000)
+		h := Heapify(slices.Clone(input), func(a, b int) bool { return a < b })
+
+		if h.Len() != len(input) {
+			t.Errorf("Len %d != expected %d", h.Len(), len(input))
+		}
+		if got := drain(h); !slices.Equal(got, slices.Sorted(slices.Values(input))) {
+			t.Error("Heapify did not produce a valid heap")
+		}
+	})
+
+	t.Run("PushPopReplace", func(t *testing.T) {
+		h := Heapify([]int{3, 5, 7}, func(a, b int) bool { return a < b })
+
+		if got := h.PushPop(1); got != 1 {
+			t.Errorf("PushPop(1) = %d != expected 1", got)
+		}
+		if got := h.PushPop(4); got != 3 {
+			t.Errorf("PushPop(4) = %d != expected 3", got)
+		}
+		if got, err := h.Replace(9); got != 4 || err != nil {
+			t.Errorf("Replace(9) = %d (%v) != expected 4", got, err)
+		}
+		if got := drain(h); !slices.Equal(got, []int{5, 7, 9}) {
+			t.Errorf("Remaining %v != expected [5 7 9]", got)
+		}
+		if got := h.PushPop(2); got != 2 || h.Len() != 0 {
+			t.Errorf("PushPop on empty = %d with Len %d", got, h.Len())
+		}
+	})
+
+	t.Run("Model", func(t *testing.T) {
+		rng := rand.New(rand.NewPCG(1, 2))
+		h := NewMinHeap[int]()
+		var model []int
+
+		for step := range 10000 {
+			switch op := rng.IntN(4); {
+			case op < 2:
+				val := rng.IntN(100)
+				h.Enqueue(val)
+				model = append(model, val)
+			case op == 2 && len(model) > 0:
+				val, _ := h.Dequeue()
+				slices.Sort(model)
+				if val != model[0] {
+					t.Fatalf("Step %d: Dequeue %d != expected %d", step, val, model[0])
+				}
+				model = model[1:]
+			default:
+				val := rng.IntN(100)
+				got := h.PushPop(val)
+				model = append(model, val)
+				slices.Sort(model)
+				if got != model[0] {
+					t.Fatalf("Step %d: PushPop %d != expected %d", step, got, model[0])
+				}
+				model = model[1:]
+			}
+			if h.Len() != len(model) {
+				t.Fatalf("Step %d: Len %d != expected %d", step, h.Len(), len(model))
+			}
+		}
+
+		h.Clear()
+		if !h.IsEmpty() {
+			t.Error("Heap not empty after Clear")
+		}
+	})
+}
+
+type intHeap []int
+
+func (h intHeap) Len() int           { return len(h) }
+func (h intHeap) Less(i, j int) bool { return h[i] < h[j] }
+func (h intHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+func (h *intHeap) Push(x any)        { *h = append(*h, x.(int)) }
+func (h *intHeap) Pop() any {
+	old := *h
+	x := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return x
+}
+
+func BenchmarkHeap(b *testing.B) {
+	const n = 1024
+	input := rand.New(rand.NewPCG(1, 2)).Perm(n)
+
+	b.Run("BinaryHeap", func(b *testing.B) {
+		h := NewMinHeap[int]()
+		for b.Loop() {
+			for _, val := range input {
+				h.Enqueue(val)
+			}
+			for range n {
+				h.Dequeue()
+			}
+		}
+	})
+
+	b.Run("ContainerHeap", func(b *testing.B) {
+		h := &intHeap{}
+		for b.Loop() {
+			for _, val := range input {
+				heap.Push(h, val)
+			}
+			for range n {
+				heap.Pop(h)
+			}
+		}
+	})
+
+	b.Run("Heapify/BinaryHeap", func(b *testing.B) {
+		data := make([]int, n)
+		for b.Loop() {
+			copy(data, input)
+			Heapify(data, func(a, b int) bool { return a < b })
+		}
+	})
+
+	b.Run("Heapify/ContainerHeap", func(b *testing.B) {
+		data := make(intHeap, n)
+		for b.Loop() {
+			copy(data, input)
+			heap.Init(&data)
+		}
+	})
+}
@@ -0,0 +1,16 @@
+package priority_queue
+
+import "fmt"
+
+type PriorityQueueConstraintError struct {
+	Constraint string
+}
+
+func (e *PriorityQueueConstraintError) Error() string {
+	return fmt.Sprintf("Constraint violated: %s", e.Constraint)
+}
+
+func (e *PriorityQueueConstraintError) Is(target error) bool {
+	_, ok := target.(*PriorityQueueConstraintError)
+	return ok
+}
@@ -0,0 +1,270 @@
+package priority_queue
+
+import (
+	"math/bits"
+
+	"github.com/jdavasligil/golang-dsa/abstract/container"
+	"github.com/jdavasligil/golang-dsa/abstract/queue"
+	"github.com/jdavasligil/golang-dsa/internal/owner"
+)
+
+var (
+	_ queue.Queue[int]    = (*FibonacciHeap[int])(nil)
+	_ container.Sized     = (*FibonacciHeap[int])(nil)
+	_ container.Clearable = (*FibonacciHeap[int])(nil)
+)
+
+var (
+	errFibonacciDequeueEmpty = &container.EmptyError{Container: "FibonacciHeap", Op: "dequeue"}
+	errFibonacciEmpty        = &container.EmptyError{Container: "FibonacciHeap"}
+	errFibonacciHandle       = &container.HandleError{Container: "FibonacciHeap"}
+)
+
+// FibonacciNode is a handle to a value in a FibonacciHeap. It stays valid
+// until the value is dequeued, including after the heap is melded into
+// another.
+type FibonacciNode[T any] struct {
+	value       T
+	parent      *FibonacciNode[T]
+	child       *FibonacciNode[T]
+	left, right *FibonacciNode[T]
+	degree      int
+	// Whether the node lost a child since it last became a child itself.
+	marked bool
+	owner  *owner.Owner
+}
+
+func (n *FibonacciNode[T]) Value() T {
+	return n.value
+}
+
+// FibonacciHeap is a forest of heap ordered trees kept in circular sibling
+// lists. Insert, Meld and DecreaseKey are amortized O(1) and Dequeue is
+// amortized O(log n).
+type FibonacciHeap[T any] struct {
+	min  *FibonacciNode[T]
+	size int
+	less func(a, b T) bool
+	id   *owner.Owner
+}
+
+func NewFibonacciHeap[T any](less func(a, b T) bool) *FibonacciHeap[T] {
+	return &FibonacciHeap[T]{less: less, id: &owner.Owner{}}
+}
+
+// Join the circular lists containing a and b.
+func splice[T any](a, b *FibonacciNode[T]) {
+	aRight, bLeft := a.right, b.left
+	a.right = b
+	b.left = a
+	bLeft.right = aRight
+	aRight.left = bLeft
+}
+
+// Remove n from its sibling list, leaving it a list of one.
+func detach[T any](n *FibonacciNode[T]) {
+	n.left.right = n.right
+	n.right.left = n.left
+	n.left = n
+	n.right = n
+}
+
+// Add a list of roots and keep track of the least.
+func (h *FibonacciHeap[T]) addRoots(n *FibonacciNode[T]) {
+	if h.min == nil {
+		h.min = n
+		return
+	}
+	splice(h.min, n)
+	if h.less(n.value, h.min.value) {
+		h.min = n
+	}
+}
+
+func (h *FibonacciHeap[T]) Insert(value T) *FibonacciNode[T] {
+	n := &FibonacciNode[T]{value: value, owner: h.id}
+	n.left = n
+	n.right = n
+	h.addRoots(n)
+	h.size++
+	return n
+}
+
+// Enqueue never fails. The error is for conformance with queue.Queue.
+func (h *FibonacciHeap[T]) Enqueue(value T) error {
+	h.Insert(value)
+	return nil
+}
+
+func (h *FibonacciHeap[T]) Dequeue() (T, error) {
+	var result T
+
+	if h.min == nil {
+		return result, errFibonacciDequeueEmpty
+	}
+
+	z := h.min
+	if c := z.child; c != nil {
+		for n := c; ; n = n.right {
+			n.parent = nil
+			if n.right == c {
+				break
+			}
+		}
+		splice(z, c)
+		z.child = nil
+	}
+
+	if z.right == z {
+		h.min = nil
+	} else {
+		h.min = z.right
+		detach(z)
+		h.consolidate()
+	}
+	h.size--
+	z.owner = nil
+
+	return z.value, nil
+}
+
+// Link roots of equal degree until every root has a distinct degree.
+func (h *FibonacciHeap[T]) consolidate() {
+	var roots []*FibonacciNode[T]
+	for n := h.min; ; n = n.right {
+		roots = append(roots, n)
+		if n.right == h.min {
+			break
+		}
+	}
+
+	byDegree := make([]*FibonacciNode[T], bits.Len(uint(h.size))*2+1)
+	for _, x := range roots {
+		for byDegree[x.degree] != nil {
+			y := byDegree[x.degree]
+			byDegree[x.degree] = nil
+			if h.less(y.value, x.value) {
+				x, y = y, x
+			}
+			detach(y)
+			y.parent = x
+			y.marked = false
+			if x.child == nil {
+				x.child = y
+			} else {
+				splice(x.child, y)
+			}
+			x.degree++
+		}
+		byDegree[x.degree] = x
+	}
+
+	h.min = nil
+	for _, n := range byDegree {
+		if n != nil {
+			n.left = n
+			n.right = n
+			h.addRoots(n)
+		}
+	}
+}
+
+func (h *FibonacciHeap[T]) Peek() (T, error) {
+	var result T
+
+	if h.min == nil {
+		return result, errFibonacciEmpty
+	}
+
+	return h.min.value, nil
+}
+
+// Contains reports whether n is currently in h.
+func (h *FibonacciHeap[T]) Contains(n *FibonacciNode[T]) bool {
+	return n != nil && n.owner != nil && n.owner.Find() == h.id
+}
+
+// Move n from its parent to the root list.
+func (h *FibonacciHeap[T]) cut(n *FibonacciNode[T]) {
+	p := n.parent
+	if p.child == n {
+		if n.right == n {
+			p.child = nil
+		} else {
+			p.child = n.right
+		}
+	}
+	detach(n)
+	p.degree--
+	n.parent = nil
+	n.marked = false
+	h.addRoots(n)
+}
+
+// DecreaseKey replaces the value of n with one which is not greater.
+func (h *FibonacciHeap[T]) DecreaseKey(n *FibonacciNode[T], value T) error {
+	if !h.Contains(n) {
+		return errFibonacciHandle
+	}
+	if h.less(n.value, value) {
+		return errDecreaseKey
+	}
+
+	n.value = value
+	p := n.parent
+	if p == nil {
+		if h.less(n.value, h.min.value) {
+			h.min = n
+		}
+		return nil
+	}
+	if !h.less(n.value, p.value) {
+		return nil
+	}
+
+	// Cascading cut: a parent losing its second child is cut as well, which
+	// bounds tree size exponentially in degree.
+	h.cut(n)
+	for p.parent != nil {
+		if !p.marked {
+			p.marked = true
+			break
+		}
+		next := p.parent
+		h.cut(p)
+		p = next
+	}
+
+	return nil
+}
+
+// Meld moves every value of other into h in O(1). Handles into other remain
+// valid and now belong to h. other is left empty.
+func (h *FibonacciHeap[T]) Meld(other *FibonacciHeap[T]) {
+	if other == h || other.min == nil {
+		return
+	}
+
+	h.addRoots(other.min)
+	h.size += other.size
+
+	other.id.Forward(h.id)
+	other.id = &owner.Owner{}
+	other.min = nil
+	other.size = 0
+}
+
+func (h *FibonacciHeap[T]) Len() int {
+	return h.size
+}
+
+func (h *FibonacciHeap[T]) IsEmpty() bool {
+	return h.size == 0
+}
+
+// Clear removes every value. Existing handles become invalid.
+func (h *FibonacciHeap[T]) Clear() {
+	h.id = &owner.Owner{}
+	h.min = nil
+	h.size = 0
+}
@@ -0,0 +1,194 @@
+package priority_queue
+
+import (
+	"cmp"
+	"fmt"
+	"iter"
+
+	"github.com/jdavasligil/golang-dsa/abstract/container"
+)
+
+var (
+	_ container.Sized     = (*IndexedPriorityQueue[int, int])(nil)
+	_ container.Clearable = (*IndexedPriorityQueue[int, int])(nil)
+)
+
+var (
+	errIndexedPopEmpty = &container.EmptyError{Container: "IndexedPriorityQueue", Op: "pop"}
+	errIndexedEmpty    = &container.EmptyError{Container: "IndexedPriorityQueue"}
+	errIndexedHandle   = &container.HandleError{Container: "IndexedPriorityQueue"}
+)
+
+// Handle is a stable reference to a value queued in an IndexedPriorityQueue.
+// It stays valid until the value is popped or removed.
+type Handle[T, P any] struct {
+	Value T
+
+	priority P
+	index    int
+	queue    *IndexedPriorityQueue[T, P]
+}
+
+func (h *Handle[T, P]) Priority() P {
+	return h.priority
+}
+
+// IndexedPriorityQueue is a d-ary heap of values ordered by a separate
+// priority, which can be changed while the value is queued.
+type IndexedPriorityQueue[T, P any] struct {
+	heap  []*Handle[T, P]
+	arity int
+	less  func(a, b P) bool
+}
+
+// NewIndexedPriorityQueue pops the lowest priority first using a binary heap.
+func NewIndexedPriorityQueue[T any, P cmp.Ordered]() *IndexedPriorityQueue[T, P] {
+	return &IndexedPriorityQueue[T, P]{arity: 2, less: cmp.Less[P]}
+}
+
+// NewIndexedPriorityQueueFunc uses a heap with arity children per node.
+// Higher arity makes Update cheaper and Pop more expensive. Pop returns the
+// value whose priority is less than every other.
+func NewIndexedPriorityQueueFunc[T, P any](arity int, less func(a, b P) bool) (*IndexedPriorityQueue[T, P], error) {
+	if arity < 2 {
+		return nil, &PriorityQueueConstraintError{fmt.Sprintf("Arity %d >= 2", arity)}
+	}
+	return &IndexedPriorityQueue[T, P]{arity: arity, less: less}, nil
+}
+
+func (q *IndexedPriorityQueue[T, P]) swap(i, j int) {
+	q.heap[i], q.heap[j] = q.heap[j], q.heap[i]
+	q.heap[i].index = i
+	q.heap[j].index = j
+}
+
+func (q *IndexedPriorityQueue[T, P]) up(i int) {
+	for i > 0 {
+		parent := (i - 1) / q.arity
+		if !q.less(q.heap[i].priority, q.heap[parent].priority) {
+			return
+		}
+		q.swap(i, parent)
+		i = parent
+	}
+}
+
+func (q *IndexedPriorityQueue[T, P]) down(i int) {
+	n := len(q.heap)
+	for {
+		best := i
+		first := q.arity*i + 1
+		for c := first; c < min(first+q.arity, n); c++ {
+			if q.less(q.heap[c].priority, q.heap[best].priority) {
+				best = c
+			}
+		}
+		if best == i {
+			return
+		}
+		q.swap(i, best)
+		i = best
+	}
+}
+
+// Detach the handle at index i, filling the gap with the last handle.
+func (q *IndexedPriorityQueue[T, P]) removeAt(i int) *Handle[T, P] {
+	h := q.heap[i]
+	last := len(q.heap) - 1
+	if i != last {
+		q.swap(i, last)
+	}
+	q.heap[last] = nil
+	q.heap = q.heap[:last]
+	if i != last {
+		q.down(i)
+		q.up(i)
+	}
+
+	h.index = -1
+	h.queue = nil
+
+	return h
+}
+
+func (q *IndexedPriorityQueue[T, P]) Push(value T, priority P) *Handle[T, P] {
+	h := &Handle[T, P]{Value: value, priority: priority, index: len(q.heap), queue: q}
+	q.heap = append(q.heap, h)
+	q.up(h.index)
+	return h
+}
+
+// Pop removes the value that comes first under the ordering chosen at
+// construction: the lowest priority for NewIndexedPriorityQueue, or the least
+// priority under less for NewIndexedPriorityQueueFunc. The returned handle is
+// no longer contained in q.
+func (q *IndexedPriorityQueue[T, P]) Pop() (*Handle[T, P], error) {
+	if len(q.heap) == 0 {
+		return nil, errIndexedPopEmpty
+	}
+	return q.removeAt(0), nil
+}
+
+// Peek returns the handle Pop would remove without removing it.
+func (q *IndexedPriorityQueue[T, P]) Peek() (*Handle[T, P], error) {
+	if len(q.heap) == 0 {
+		return nil, errIndexedEmpty
+	}
+	return q.heap[0], nil
+}
+
+// Contains reports whether h is currently queued in q.
+func (q *IndexedPriorityQueue[T, P]) Contains(h *Handle[T, P]) bool {
+	return h != nil && h.queue == q
+}
+
+// Update changes the priority of a queued value, moving it either way.
+func (q *IndexedPriorityQueue[T, P]) Update(h *Handle[T, P], priority P) error {
+	if !q.Contains(h) {
+		return errIndexedHandle
+	}
+
+	h.priority = priority
+	q.down(h.index)
+	q.up(h.index)
+
+	return nil
+}
+
+// Remove takes a queued value out of q and invalidates its handle.
+func (q *IndexedPriorityQueue[T, P]) Remove(h *Handle[T, P]) (T, error) {
+	if !q.Contains(h) {
+		var result T
+		return result, errIndexedHandle
+	}
+	return q.removeAt(h.index).Value, nil
+}
+
+func (q *IndexedPriorityQueue[T, P]) Len() int {
+	return len(q.heap)
+}
+
+func (q *IndexedPriorityQueue[T, P]) IsEmpty() bool {
+	return len(q.heap) == 0
+}
+
+// Clear removes every value. Existing handles become invalid.
+func (q *IndexedPriorityQueue[T, P]) Clear() {
+	for _, h := range q.heap {
+		h.index = -1
+		h.queue = nil
+	}
+	clear(q.heap)
+	q.heap = q.heap[:0]
+}
+
+// All iterates values and priorities in heap order, which is not sorted.
+func (q *IndexedPriorityQueue[T, P]) All() iter.Seq2[T, P] {
+	return func(yield func(T, P) bool) {
+		for _, h := range q.heap {
+			if !yield(h.Value, h.priority) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,149 @@
+package priority_queue
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/jdavasligil/golang-dsa/abstract/container"
+	"github.com/jdavasligil/golang-dsa/ring_buffer"
+)
+
+func TestIndexedPriorityQueue(t *testing.T) {
+	t.Run("Empty", func(t *testing.T) {
+		q := NewIndexedPriorityQueue[string, int]()
+
+		if _, err := q.Pop(); !errors.Is(err, &container.EmptyError{}) {
+			t.Errorf("Pop Got: %v  Expected: container.EmptyError.", err)
+		}
+		if _, err := q.Peek(); !errors.Is(err, &container.EmptyError{}) {
+			t.Errorf("Peek Got: %v  Expected: container.EmptyError.", err)
+		}
+
+		// Same contract as the ring buffer's empty error.
+		if _, err := q.Pop(); !errors.Is(err, &ring_buffer.RingBufferEmptyError{}) {
+			t.Errorf("Pop Got: %v  Expected: ring_buffer.RingBufferEmptyError.", err)
+		}
+		if _, err := q.Peek(); !errors.Is(err, &ring_buffer.RingBufferEmptyError{}) {
+			t.Errorf("Peek Got: %v  Expected: ring_buffer.RingBufferEmptyError.", err)
+		}
+	})
+
+	t.Run("Arity", func(t *testing.T) {
+		less := func(a, b int) bool { return a < b }
+
+		if _, err := NewIndexedPriorityQueueFunc[string](1, less); !errors.Is(err, &PriorityQueueConstraintError{}) {
+			t.Errorf("Got: %v  Expected: PriorityQueueConstraintError.", err)
+		}
+	})
+
+	t.Run("Handles", func(t *testing.T) {
+		q := NewIndexedPriorityQueue[string, int]()
+		other := NewIndexedPriorityQueue[string, int]()
+
+		a := q.Push("a", 5)
+		b := q.Push("b", 3)
+		c := q.Push("c", 8)
+		foreign := other.Push("x", 0)
+
+		if err := q.Update(c, 1); err != nil {
+			t.Fatal(err)
+		}
+		if top, _ := q.Peek(); top != c {
+			t.Errorf("Peek %s != expected c after decrease", top.Value)
+		}
+		if err := q.Update(c, 9); err != nil {
+			t.Fatal(err)
+		}
+		if top, _ := q.Peek(); top != b {
+			t.Errorf("Peek %s != expected b after increase", top.Value)
+		}
+
+		if val, err := q.Remove(b); val != "b" || err != nil {
+			t.Errorf("Remove = %s (%v) != expected b", val, err)
+		}
+		if q.Contains(b) || q.Contains(foreign) || !q.Contains(a) {
+			t.Error("Contains reported the wrong handles")
+		}
+
+		for i, err := range []error{
+			q.Update(b, 0),
+			second(q.Remove(b)),
+			q.Update(foreign, 0),
+			q.Update(nil, 0),
+		} {
+			if !errors.Is(err, &container.HandleError{}) {
+				t.Errorf("Test %d: Got: %v  Expected: container.HandleError.", i, err)
+			}
+		}
+
+		h, _ := q.Pop()
+		if h != a || h.Priority() != 5 || q.Contains(a) {
+			t.Errorf("Pop = %s at %d != expected a at 5", h.Value, h.Priority())
+		}
+
+		q.Clear()
+		if q.Contains(c) || !q.IsEmpty() {
+			t.Error("Clear did not invalidate handles")
+		}
+	})
+
+	for _, arity := range []int{2, 3, 4, 8} {
+		t.Run(fmt.Sprintf("Model/Arity%d", arity), func(t *testing.T) {
+			rng := rand.New(rand.NewSource(int64(arity)))
+			q, _ := NewIndexedPriorityQueueFunc[int](arity, func(a, b int) bool { return a < b })
+			model := map[*Handle[int, int]]int{}
+			var handles []*Handle[int, int]
+
+			for step := range 10000 {
+				switch op := rng.Intn(5); {
+				case op < 2 || len(handles) == 0:
+					h := q.Push(step, rng.Intn(1000))
+					model[h] = h.Priority()
+					handles = append(handles, h)
+				case op == 2:
+					h := handles[rng.Intn(len(handles))]
+					priority := rng.Intn(1000)
+					err := q.Update(h, priority)
+					if _, ok := model[h]; ok != (err == nil) {
+						t.Fatalf("Step %d: Update error %v for queued=%t", step, err, ok)
+					}
+					if err == nil {
+						model[h] = priority
+					}
+				case op == 3:
+					h := handles[rng.Intn(len(handles))]
+					_, err := q.Remove(h)
+					if _, ok := model[h]; ok != (err == nil) {
+						t.Fatalf("Step %d: Remove error %v for queued=%t", step, err, ok)
+					}
+					delete(model, h)
+				default:
+					h, err := q.Pop()
+					if len(model) == 0 {
+						if err == nil {
+							t.Fatalf("Step %d: Pop from empty queue succeeded", step)
+						}
+						continue
+					}
+					lowest := slices.Min(slices.Collect(maps.Values(model)))
+					if err != nil || h.Priority() != lowest {
+						t.Fatalf("Step %d: Pop priority %d != expected %d", step, h.Priority(), lowest)
+					}
+					delete(model, h)
+				}
+
+				if q.Len() != len(model) {
+					t.Fatalf("Step %d: Len %d != expected %d", step, q.Len(), len(model))
+				}
+			}
+		})
+	}
+}
+
+func second[T any](_ T, err error) error {
+	return err
+}
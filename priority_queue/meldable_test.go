@@ -0,0 +1,132 @@
+package priority_queue
+
+import (
+	"cmp"
+	"errors"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/jdavasligil/golang-dsa/abstract/container"
+	"github.com/jdavasligil/golang-dsa/abstract/queue"
+)
+
+type meldableHeap[N interface{ Value() int }, H any] interface {
+	queue.Queue[int]
+	container.Sized
+	Insert(value int) N
+	Contains(n N) bool
+	DecreaseKey(n N, value int) error
+	Meld(other H)
+}
+
+func testMeldableHeap[N interface{ Value() int }, H meldableHeap[N, H]](t *testing.T, newHeap func() H) {
+	t.Run("Empty", func(t *testing.T) {
+		h := newHeap()
+
+		if _, err := h.Dequeue(); !errors.Is(err, &container.EmptyError{}) {
+			t.Errorf("Dequeue Got: %v  Expected: container.EmptyError.", err)
+		}
+		if _, err := h.Peek(); !errors.Is(err, &container.EmptyError{}) {
+			t.Errorf("Peek Got: %v  Expected: container.EmptyError.", err)
+		}
+	})
+
+	t.Run("Handles", func(t *testing.T) {
+		a := newHeap()
+		b := newHeap()
+
+		five := a.Insert(5)
+		seven := b.Insert(7)
+		b.Insert(3)
+
+		if err := a.DecreaseKey(five, 6); !errors.Is(err, &PriorityQueueConstraintError{}) {
+			t.Errorf("Increase Got: %v  Expected: PriorityQueueConstraintError.", err)
+		}
+		if err := a.DecreaseKey(seven, 1); !errors.Is(err, &container.HandleError{}) {
+			t.Errorf("Foreign Got: %v  Expected: container.HandleError.", err)
+		}
+
+		a.Meld(b)
+		if a.Len() != 3 || !b.IsEmpty() {
+			t.Errorf("Meld lengths %d and %d != expected 3 and 0", a.Len(), b.Len())
+		}
+		if err := a.DecreaseKey(seven, 1); err != nil {
+			t.Errorf("Melded handle rejected: %v", err)
+		}
+		if val, _ := a.Dequeue(); val != 1 || a.Contains(seven) {
+			t.Errorf("Dequeue %d != expected 1", val)
+		}
+		if err := a.DecreaseKey(seven, 0); !errors.Is(err, &container.HandleError{}) {
+			t.Errorf("Dequeued Got: %v  Expected: container.HandleError.", err)
+		}
+
+		// The emptied heap is reusable and does not own the moved values.
+		b.Insert(4)
+		if b.Contains(five) || !a.Contains(five) {
+			t.Error("Contains reported the wrong owner after Meld")
+		}
+	})
+
+	t.Run("Model", func(t *testing.T) {
+		rng := rand.New(rand.NewPCG(1, 2))
+		h := newHeap()
+		var live []N
+
+		for step := range 10000 {
+			switch op := rng.IntN(8); {
+			case op < 3:
+				live = append(live, h.Insert(rng.IntN(1000)))
+			case op == 3:
+				other := newHeap()
+				for range rng.IntN(5) {
+					live = append(live, other.Insert(rng.IntN(1000)))
+				}
+				h.Meld(other)
+			case op < 6 && len(live) > 0:
+				i := rng.IntN(len(live))
+				value := live[i].Value() - rng.IntN(100)
+				if err := h.DecreaseKey(live[i], value); err != nil {
+					t.Fatalf("Step %d: DecreaseKey failed: %v", step, err)
+				}
+			default:
+				val, err := h.Dequeue()
+				if len(live) == 0 {
+					if err == nil {
+						t.Fatalf("Step %d: Dequeue from empty heap succeeded", step)
+					}
+					continue
+				}
+
+				lowest := slices.Min(values(live))
+				if err != nil || val != lowest {
+					t.Fatalf("Step %d: Dequeue = %d (%v) != expected %d", step, val, err, lowest)
+				}
+				live = slices.DeleteFunc(live, func(n N) bool { return !h.Contains(n) })
+			}
+
+			if h.Len() != len(live) {
+				t.Fatalf("Step %d: Len %d != expected %d", step, h.Len(), len(live))
+			}
+			if top, err := h.Peek(); len(live) > 0 && (err != nil || top != slices.Min(values(live))) {
+				t.Fatalf("Step %d: Peek = %d (%v) != expected minimum", step, top, err)
+			}
+		}
+	})
+}
+
+func values[N interface{ Value() int }](nodes []N) []int {
+	out := make([]int, len(nodes))
+	for i, n := range nodes {
+		out[i] = n.Value()
+	}
+	return out
+}
+
+func TestPairingHeap(t *testing.T) {
+	testMeldableHeap(t, func() *PairingHeap[int] { return NewPairingHeap(cmp.Less[int]) })
+}
+
+func TestFibonacciHeap(t *testing.T) {
+	testMeldableHeap(t, func() *FibonacciHeap[int] { return NewFibonacciHeap(cmp.Less[int]) })
+}
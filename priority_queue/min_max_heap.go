@@ -0,0 +1,258 @@
+package priority_queue
+
+import (
+	"fmt"
+	"iter"
+	"math/bits"
+	"slices"
+
+	"github.com/jdavasligil/golang-dsa/abstract/container"
+)
+
+var (
+	_ container.Sized         = (*MinMaxHeap[int])(nil)
+	_ container.Clearable     = (*MinMaxHeap[int])(nil)
+	_ container.Iterable[int] = (*MinMaxHeap[int])(nil)
+)
+
+var (
+	errMinMaxEmpty = &container.EmptyError{Container: "MinMaxHeap"}
+	errMinMaxFull  = &container.FullError{Container: "MinMaxHeap"}
+)
+
+// MinMaxHeap is a double-ended priority queue. The front is the least element
+// according to less and the back is the greatest.
+//
+// It has the method set of deque.Deque but not its semantics: pushes insert
+// by priority rather than at an end, so it does not claim that interface.
+//
+// Nodes on even levels are less than or equal to all of their descendants and
+// nodes on odd levels are greater than or equal to them, so both ends are at
+// most one level from the root.
+type MinMaxHeap[T any] struct {
+	data     []T
+	less     func(a, b T) bool
+	capacity int
+}
+
+func NewMinMaxHeap[T any](less func(a, b T) bool) *MinMaxHeap[T] {
+	return &MinMaxHeap[T]{less: less}
+}
+
+// NewBoundedMinMaxHeap holds at most capacity elements. Pushing to a full heap
+// fails, while PushFrontOver and PushBackOver evict an element instead.
+func NewBoundedMinMaxHeap[T any](capacity int, less func(a, b T) bool) (*MinMaxHeap[T], error) {
+	if capacity < 1 {
+		return nil, &PriorityQueueConstraintError{fmt.Sprintf("Capacity %d >= 1", capacity)}
+	}
+	return &MinMaxHeap[T]{
+		data:     make([]T, 0, capacity),
+		less:     less,
+		capacity: capacity,
+	}, nil
+}
+
+func isMinLevel(i int) bool {
+	return bits.Len(uint(i+1))%2 == 1
+}
+
+// Whether a belongs closer to the root than b on the level of i.
+func (h *MinMaxHeap[T]) before(i int, a, b T) bool {
+	if isMinLevel(i) {
+		return h.less(a, b)
+	}
+	return h.less(b, a)
+}
+
+func (h *MinMaxHeap[T]) up(i int) {
+	if i == 0 {
+		return
+	}
+
+	parent := (i - 1) / 2
+	if h.before(parent, h.data[i], h.data[parent]) {
+		h.data[i], h.data[parent] = h.data[parent], h.data[i]
+		i = parent
+	}
+
+	for i > 2 {
+		grandparent := ((i-1)/2 - 1) / 2
+		if !h.before(i, h.data[i], h.data[grandparent]) {
+			return
+		}
+		h.data[i], h.data[grandparent] = h.data[grandparent], h.data[i]
+		i = grandparent
+	}
+}
+
+func (h *MinMaxHeap[T]) down(i int) {
+	n := len(h.data)
+
+	for {
+		// Find the first among the children and grandchildren of i.
+		best := i
+		for _, c := range [...]int{2*i + 1, 2*i + 2, 4*i + 3, 4*i + 4, 4*i + 5, 4*i + 6} {
+			if c < n && h.before(i, h.data[c], h.data[best]) {
+				best = c
+			}
+		}
+		if best == i {
+			return
+		}
+
+		h.data[i], h.data[best] = h.data[best], h.data[i]
+		if best <= 2*i+2 {
+			return
+		}
+
+		// The displaced element may belong above its new parent.
+		parent := (best - 1) / 2
+		if h.before(i, h.data[parent], h.data[best]) {
+			h.data[best], h.data[parent] = h.data[parent], h.data[best]
+		}
+		i = best
+	}
+}
+
+func (h *MinMaxHeap[T]) backIndex() int {
+	switch len(h.data) {
+	case 1:
+		return 0
+	case 2:
+		return 1
+	}
+	if h.less(h.data[1], h.data[2]) {
+		return 2
+	}
+	return 1
+}
+
+func (h *MinMaxHeap[T]) removeAt(i int) T {
+	result := h.data[i]
+	last := len(h.data) - 1
+	h.data[i] = h.data[last]
+	h.data[last] = *new(T)
+	h.data = h.data[:last]
+	if i < last {
+		h.down(i)
+	}
+	return result
+}
+
+func (h *MinMaxHeap[T]) push(element T) {
+	h.data = append(h.data, element)
+	h.up(len(h.data) - 1)
+}
+
+// PushBack adds element in order. It is the same as PushFront.
+func (h *MinMaxHeap[T]) PushBack(element T) error {
+	if h.IsFull() {
+		return errMinMaxFull
+	}
+	h.push(element)
+	return nil
+}
+
+// PushFront adds element in order. It is the same as PushBack.
+func (h *MinMaxHeap[T]) PushFront(element T) error {
+	return h.PushBack(element)
+}
+
+// PushBackOver adds element to a bounded heap, evicting the front (least)
+// element if full, so the heap keeps the greatest elements pushed. Returns
+// the evicted element, which is element itself if it is not greater than the
+// front.
+func (h *MinMaxHeap[T]) PushBackOver(element T) (T, bool) {
+	if !h.IsFull() {
+		h.push(element)
+		return *new(T), false
+	}
+	if !h.less(h.data[0], element) {
+		return element, true
+	}
+	evicted := h.removeAt(0)
+	h.push(element)
+	return evicted, true
+}
+
+// PushFrontOver adds element to a bounded heap, evicting the back (greatest)
+// element if full, so the heap keeps the least elements pushed. Returns the
+// evicted element, which is element itself if it is not less than the back.
+func (h *MinMaxHeap[T]) PushFrontOver(element T) (T, bool) {
+	if !h.IsFull() {
+		h.push(element)
+		return *new(T), false
+	}
+	back := h.backIndex()
+	if !h.less(element, h.data[back]) {
+		return element, true
+	}
+	evicted := h.removeAt(back)
+	h.push(element)
+	return evicted, true
+}
+
+// PopFront removes the least element.
+func (h *MinMaxHeap[T]) PopFront() (T, error) {
+	if len(h.data) == 0 {
+		var result T
+		return result, errMinMaxEmpty
+	}
+	return h.removeAt(0), nil
+}
+
+// PopBack removes the greatest element.
+func (h *MinMaxHeap[T]) PopBack() (T, error) {
+	if len(h.data) == 0 {
+		var result T
+		return result, errMinMaxEmpty
+	}
+	return h.removeAt(h.backIndex()), nil
+}
+
+func (h *MinMaxHeap[T]) Front() (T, error) {
+	var result T
+
+	if len(h.data) == 0 {
+		return result, errMinMaxEmpty
+	}
+
+	return h.data[0], nil
+}
+
+func (h *MinMaxHeap[T]) Back() (T, error) {
+	var result T
+
+	if len(h.data) == 0 {
+		return result, errMinMaxEmpty
+	}
+
+	return h.data[h.backIndex()], nil
+}
+
+func (h *MinMaxHeap[T]) Len() int {
+	return len(h.data)
+}
+
+func (h *MinMaxHeap[T]) IsEmpty() bool {
+	return len(h.data) == 0
+}
+
+// Cap returns the capacity of a bounded heap, or 0 if unbounded.
+func (h *MinMaxHeap[T]) Cap() int {
+	return h.capacity
+}
+
+func (h *MinMaxHeap[T]) IsFull() bool {
+	return h.capacity > 0 && len(h.data) >= h.capacity
+}
+
+func (h *MinMaxHeap[T]) Clear() {
+	clear(h.data)
+	h.data = h.data[:0]
+}
+
+// All iterates in heap order, which is not sorted.
+func (h *MinMaxHeap[T]) All() iter.Seq[T] {
+	return slices.Values(h.data)
+}
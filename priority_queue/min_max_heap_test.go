@@ -0,0 +1,122 @@
+package priority_queue
+
+import (
+	"cmp"
+	"errors"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/jdavasligil/golang-dsa/abstract/container"
+)
+
+func TestMinMaxHeap(t *testing.T) {
+	t.Run("Empty", func(t *testing.T) {
+		h := NewMinMaxHeap(cmp.Less[int])
+
+		reads := []struct {
+			name string
+			read func() (int, error)
+		}{
+			{name: "PopFront", read: h.PopFront},
+			{name: "PopBack", read: h.PopBack},
+			{name: "Front", read: h.Front},
+			{name: "Back", read: h.Back},
+		}
+
+		for _, r := range reads {
+			if _, err := r.read(); !errors.Is(err, &container.EmptyError{}) {
+				t.Errorf("%s Got: %v  Expected: container.EmptyError.", r.name, err)
+			}
+		}
+	})
+
+	t.Run("Bounded", func(t *testing.T) {
+		if _, err := NewBoundedMinMaxHeap(0, cmp.Less[int]); !errors.Is(err, &PriorityQueueConstraintError{}) {
+			t.Errorf("Got: %v  Expected: PriorityQueueConstraintError.", err)
+		}
+
+		h, _ := NewBoundedMinMaxHeap(3, cmp.Less[int])
+		for _, val := range []int{5, 1, 9} {
+			h.PushBack(val)
+		}
+		if err := h.PushFront(4); !errors.Is(err, &container.FullError{}) {
+			t.Errorf("Got: %v  Expected: container.FullError.", err)
+		}
+
+		if evicted, ok := h.PushBackOver(4); !ok || evicted != 1 {
+			t.Errorf("PushBackOver(4) evicted %d (%t) != expected 1", evicted, ok)
+		}
+		if evicted, ok := h.PushBackOver(2); !ok || evicted != 2 {
+			t.Errorf("PushBackOver(2) evicted %d (%t) != expected 2", evicted, ok)
+		}
+		if evicted, ok := h.PushFrontOver(3); !ok || evicted != 9 {
+			t.Errorf("PushFrontOver(3) evicted %d (%t) != expected 9", evicted, ok)
+		}
+		if got := slices.Sorted(h.All()); !slices.Equal(got, []int{3, 4, 5}) {
+			t.Errorf("Contents %v != expected [3 4 5]", got)
+		}
+
+		h.PopBack()
+		if _, ok := h.PushFrontOver(0); ok {
+			t.Error("PushFrontOver evicted from a heap with space")
+		}
+	})
+
+	t.Run("TopK", func(t *testing.T) {
+		const k = 10
+		input := rand.New(rand.NewPCG(1, 2)).Perm(1000)
+		h, _ := NewBoundedMinMaxHeap(k, cmp.Less[int])
+
+		for _, val := range input {
+			h.PushBackOver(val)
+		}
+
+		for i := range k {
+			if val, _ := h.PopBack(); val != 999-i {
+				t.Fatalf("PopBack %d != expected %d", val, 999-i)
+			}
+		}
+	})
+
+	t.Run("Model", func(t *testing.T) {
+		rng := rand.New(rand.NewPCG(1, 2))
+		h := NewMinMaxHeap(cmp.Less[int])
+		var model []int
+
+		check := func(step int, op string, val int, err error, idx int) {
+			t.Helper()
+			if err != nil || val != model[idx] {
+				t.Fatalf("Step %d: %s = %d (%v) != expected %d", step, op, val, err, model[idx])
+			}
+		}
+
+		for step := range 10000 {
+			switch op := rng.IntN(6); {
+			case op < 2 || len(model) == 0:
+				val := rng.IntN(100)
+				h.PushBack(val)
+				model = append(model, val)
+				slices.Sort(model)
+			case op == 2:
+				val, err := h.PopFront()
+				check(step, "PopFront", val, err, 0)
+				model = model[1:]
+			case op == 3:
+				val, err := h.PopBack()
+				check(step, "PopBack", val, err, len(model)-1)
+				model = model[:len(model)-1]
+			case op == 4:
+				val, err := h.Front()
+				check(step, "Front", val, err, 0)
+			default:
+				val, err := h.Back()
+				check(step, "Back", val, err, len(model)-1)
+			}
+
+			if h.Len() != len(model) {
+				t.Fatalf("Step %d: Len %d != expected %d", step, h.Len(), len(model))
+			}
+		}
+	})
+}
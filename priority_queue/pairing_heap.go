@@ -0,0 +1,201 @@
+package priority_queue
+
+import (
+	"github.com/jdavasligil/golang-dsa/abstract/container"
+	"github.com/jdavasligil/golang-dsa/abstract/queue"
+	"github.com/jdavasligil/golang-dsa/internal/owner"
+)
+
+var (
+	_ queue.Queue[int]    = (*PairingHeap[int])(nil)
+	_ container.Sized     = (*PairingHeap[int])(nil)
+	_ container.Clearable = (*PairingHeap[int])(nil)
+)
+
+var (
+	errPairingDequeueEmpty = &container.EmptyError{Container: "PairingHeap", Op: "dequeue"}
+	errPairingEmpty        = &container.EmptyError{Container: "PairingHeap"}
+	errPairingHandle       = &container.HandleError{Container: "PairingHeap"}
+	errDecreaseKey         = &PriorityQueueConstraintError{"DecreaseKey value must not be greater than the current value"}
+)
+
+// PairingNode is a handle to a value in a PairingHeap. It stays valid until
+// the value is dequeued, including after the heap is melded into another.
+type PairingNode[T any] struct {
+	value   T
+	child   *PairingNode[T]
+	sibling *PairingNode[T]
+	// Parent if this is the first child, otherwise the previous sibling.
+	prev  *PairingNode[T]
+	owner *owner.Owner
+}
+
+func (n *PairingNode[T]) Value() T {
+	return n.value
+}
+
+// PairingHeap is a heap ordered tree of arbitrary shape. Insert, Meld and
+// DecreaseKey are O(1) and Dequeue is amortized O(log n).
+type PairingHeap[T any] struct {
+	root *PairingNode[T]
+	size int
+	less func(a, b T) bool
+	id   *owner.Owner
+}
+
+func NewPairingHeap[T any](less func(a, b T) bool) *PairingHeap[T] {
+	return &PairingHeap[T]{less: less, id: &owner.Owner{}}
+}
+
+// Link two roots, making the greater the first child of the lesser.
+func (h *PairingHeap[T]) link(a, b *PairingNode[T]) *PairingNode[T] {
+	if a == nil {
+		return b
+	}
+	if b == nil {
+		return a
+	}
+	if h.less(b.value, a.value) {
+		a, b = b, a
+	}
+
+	b.prev = a
+	b.sibling = a.child
+	if a.child != nil {
+		a.child.prev = b
+	}
+	a.child = b
+
+	return a
+}
+
+// Two pass pairing: link siblings left to right in pairs, then fold the pairs
+// right to left into a single tree.
+func (h *PairingHeap[T]) mergePairs(first *PairingNode[T]) *PairingNode[T] {
+	var pairs []*PairingNode[T]
+
+	for first != nil {
+		a := first
+		b := a.sibling
+		if b == nil {
+			first = nil
+		} else {
+			first = b.sibling
+			b.prev, b.sibling = nil, nil
+		}
+		a.prev, a.sibling = nil, nil
+		pairs = append(pairs, h.link(a, b))
+	}
+
+	var root *PairingNode[T]
+	for i := len(pairs) - 1; i >= 0; i-- {
+		root = h.link(pairs[i], root)
+	}
+
+	return root
+}
+
+func (h *PairingHeap[T]) Insert(value T) *PairingNode[T] {
+	n := &PairingNode[T]{value: value, owner: h.id}
+	h.root = h.link(h.root, n)
+	h.size++
+	return n
+}
+
+// Enqueue never fails. The error is for conformance with queue.Queue.
+func (h *PairingHeap[T]) Enqueue(value T) error {
+	h.Insert(value)
+	return nil
+}
+
+func (h *PairingHeap[T]) Dequeue() (T, error) {
+	var result T
+
+	if h.root == nil {
+		return result, errPairingDequeueEmpty
+	}
+
+	n := h.root
+	h.root = h.mergePairs(n.child)
+	h.size--
+
+	n.child = nil
+	n.owner = nil
+
+	return n.value, nil
+}
+
+func (h *PairingHeap[T]) Peek() (T, error) {
+	var result T
+
+	if h.root == nil {
+		return result, errPairingEmpty
+	}
+
+	return h.root.value, nil
+}
+
+// Contains reports whether n is currently in h.
+func (h *PairingHeap[T]) Contains(n *PairingNode[T]) bool {
+	return n != nil && n.owner != nil && n.owner.Find() == h.id
+}
+
+// DecreaseKey replaces the value of n with one which is not greater.
+func (h *PairingHeap[T]) DecreaseKey(n *PairingNode[T], value T) error {
+	if !h.Contains(n) {
+		return errPairingHandle
+	}
+	if h.less(n.value, value) {
+		return errDecreaseKey
+	}
+
+	n.value = value
+	if n == h.root {
+		return nil
+	}
+
+	// Cut the subtree rooted at n and link it back in as a root.
+	if n.prev.child == n {
+		n.prev.child = n.sibling
+	} else {
+		n.prev.sibling = n.sibling
+	}
+	if n.sibling != nil {
+		n.sibling.prev = n.prev
+	}
+	n.prev, n.sibling = nil, nil
+	h.root = h.link(h.root, n)
+
+	return nil
+}
+
+// Meld moves every value of other into h in O(1). Handles into other remain
+// valid and now belong to h. other is left empty.
+func (h *PairingHeap[T]) Meld(other *PairingHeap[T]) {
+	if other == h || other.root == nil {
+		return
+	}
+
+	h.root = h.link(h.root, other.root)
+	h.size += other.size
+
+	other.id.Forward(h.id)
+	other.id = &owner.Owner{}
+	other.root = nil
+	other.size = 0
+}
+
+func (h *PairingHeap[T]) Len() int {
+	return h.size
+}
+
+func (h *PairingHeap[T]) IsEmpty() bool {
+	return h.size == 0
+}
+
+// Clear removes every value. Existing handles become invalid.
+func (h *PairingHeap[T]) Clear() {
+	h.id = &owner.Owner{}
+	h.root = nil
+	h.size = 0
+}
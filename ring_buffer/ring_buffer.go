@@ -2,7 +2,10 @@ package ring_buffer
 
 import (
 	"fmt"
+	"iter"
 	"strings"
+
+	"github.com/jdavasligil/golang-dsa/abstract/container"
 )
 
 type RingBuffer[T any] struct {
@@ -16,27 +19,23 @@ func NewRingBuffer[T any](capacity int) *RingBuffer[T] {
 	return &RingBuffer[T]{data: make([]T, capacity, capacity)}
 }
 
-type RingBufferFullError struct{}
-
-func (e *RingBufferFullError) Error() string {
-	return "Ring buffer is full."
-}
-
-func (e *RingBufferFullError) Is(target error) bool {
-	_, ok := target.(*RingBufferFullError)
-	return ok
-}
+// Deprecated: Use container.FullError.
+type RingBufferFullError = container.FullError
 
-type RingBufferEmptyError struct{}
+// Deprecated: Use container.EmptyError.
+type RingBufferEmptyError = container.EmptyError
 
-func (e *RingBufferEmptyError) Error() string {
-	return "Ring buffer is empty."
-}
+var (
+	_ container.Sized         = (*RingBuffer[int])(nil)
+	_ container.Bounded       = (*RingBuffer[int])(nil)
+	_ container.Clearable     = (*RingBuffer[int])(nil)
+	_ container.Iterable[int] = (*RingBuffer[int])(nil)
+)
 
-func (e *RingBufferEmptyError) Is(target error) bool {
-	_, ok := target.(*RingBufferEmptyError)
-	return ok
-}
+var (
+	errFull  = &container.FullError{Container: "Ring buffer"}
+	errEmpty = &container.EmptyError{Container: "Ring buffer"}
+)
 
 func (q *RingBuffer[T]) IsEmpty() bool {
 	return q.length == 0
@@ -46,9 +45,28 @@ func (q *RingBuffer[T]) IsFull() bool {
 	return q.length == cap(q.data)
 }
 
+func (q *RingBuffer[T]) Len() int {
+	return q.length
+}
+
+func (q *RingBuffer[T]) Cap() int {
+	return cap(q.data)
+}
+
+// All iterates from front to back.
+func (q *RingBuffer[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := range q.length {
+			if !yield(q.data[(q.front+i)%cap(q.data)]) {
+				return
+			}
+		}
+	}
+}
+
 func (q *RingBuffer[T]) PushBack(element T) error {
 	if q.IsFull() {
-		return &RingBufferFullError{}
+		return errFull
 	}
 
 	q.data[q.back] = element
@@ -77,7 +95,7 @@ func (q *RingBuffer[T]) PopFront() (T, error) {
 	var result T
 
 	if q.IsEmpty() {
-		return result, &RingBufferEmptyError{}
+		return result, errEmpty
 	}
 
 	result = q.data[q.front]
@@ -95,7 +113,7 @@ func (q *RingBuffer[T]) PeekFront() (T, error) {
 	var result T
 
 	if q.IsEmpty() {
-		return result, &RingBufferEmptyError{}
+		return result, errEmpty
 	}
 	result = q.data[q.front]
 
@@ -106,7 +124,7 @@ func (q *RingBuffer[T]) PeekBack() (T, error) {
 	var result T
 
 	if q.IsEmpty() {
-		return result, &RingBufferEmptyError{}
+		return result, errEmpty
 	}
 	result = q.data[q.back]
 
@@ -2,7 +2,12 @@ package ring_buffer
 
 import (
 	"errors"
+	"slices"
 	"testing"
+
+	"github.com/jdavasligil/golang-dsa/abstract/container"
+	"github.com/jdavasligil/golang-dsa/abstract/queue"
+	"github.com/jdavasligil/golang-dsa/abstract/queue/queuetest"
 )
 
 func TestRingBuffer(t *testing.T) {
@@ -48,3 +53,57 @@ func TestRingBuffer(t *testing.T) {
 		}
 	})
 }
+
+func TestRingBufferErrors(t *testing.T) {
+	queue := NewRingBuffer[rune](1)
+
+	_, err := queue.Dequeue()
+	if !errors.Is(err, &container.EmptyError{}) {
+		t.Errorf("Got: %v  Expected: container.EmptyError.", err)
+	}
+	if err.Error() != "Ring buffer is empty." {
+		t.Errorf("Message %q changed", err.Error())
+	}
+	if !errors.Is(err, &RingBufferEmptyError{}) {
+		t.Errorf("Legacy RingBufferEmptyError match failed for %v", err)
+	}
+
+	queue.Enqueue('a')
+	err = queue.Enqueue('b')
+
+	var fullErr *container.FullError
+	if !errors.As(err, &fullErr) || fullErr.Container != "Ring buffer" {
+		t.Errorf("Got: %v  Expected: container.FullError.", err)
+	}
+	if !errors.Is(err, &RingBufferFullError{}) || err.Error() != "Ring buffer is full." {
+		t.Errorf("Legacy RingBufferFullError match failed for %v", err)
+	}
+}
+
+func TestRingBufferQueueConformance(t *testing.T) {
+	queuetest.RunQueueTests(t, func(capacity int) queue.Queue[int] { return NewRingBuffer[int](capacity) })
+}
+
+func TestRingBufferCapabilities(t *testing.T) {
+	queue := NewRingBuffer[rune](3)
+
+	if !queue.IsEmpty() || queue.Len() != 0 || queue.Cap() != 3 {
+		t.Errorf("Len %d Cap %d IsEmpty %v on new buffer", queue.Len(), queue.Cap(), queue.IsEmpty())
+	}
+
+	for _, r := range "abcd" {
+		queue.PushBackOver(r)
+	}
+
+	if got := string(slices.Collect(queue.All())); got != "bcd" {
+		t.Errorf("All = %s != expected bcd", got)
+	}
+	if !queue.IsFull() || queue.Len() != 3 {
+		t.Errorf("Len %d IsFull %v != expected 3 true", queue.Len(), queue.IsFull())
+	}
+
+	queue.Clear()
+	if !queue.IsEmpty() || len(slices.Collect(queue.All())) != 0 {
+		t.Error("Clear left elements behind")
+	}
+}
@@ -0,0 +1,80 @@
+package stack_list
+
+import "sync"
+
+// NodeAllocator supplies and recycles nodes for a StackList.
+//
+// With an allocator, removed nodes are reused. Node handles such as those
+// returned by Find or InsertAfter must not be used after their element is
+// removed.
+type NodeAllocator[T any] interface {
+	Get() *Node[T]
+	Put(n *Node[T])
+}
+
+// FreeList keeps up to a fixed number of released nodes in a singly linked
+// chain. It is not safe for concurrent use, so share one only between lists
+// owned by the same goroutine.
+type FreeList[T any] struct {
+	head    *Node[T]
+	size    int
+	maxSize int
+}
+
+func NewFreeList[T any](maxSize int) *FreeList[T] {
+	return &FreeList[T]{maxSize: maxSize}
+}
+
+func (f *FreeList[T]) Get() *Node[T] {
+	if f.head == nil {
+		return &Node[T]{}
+	}
+
+	n := f.head
+	f.head = n.Next
+	n.Next = nil
+	f.size--
+
+	return n
+}
+
+func (f *FreeList[T]) Put(n *Node[T]) {
+	if f.size >= f.maxSize {
+		return
+	}
+
+	var zero T
+	n.Data = zero
+	n.Next = f.head
+	f.head = n
+	f.size++
+}
+
+// Len returns the number of nodes waiting to be reused.
+func (f *FreeList[T]) Len() int {
+	return f.size
+}
+
+// SyncPool recycles nodes through a sync.Pool. It may be shared by lists
+// used from different goroutines, and idle nodes are released by the garbage
+// collector.
+type SyncPool[T any] struct {
+	pool sync.Pool
+}
+
+func NewSyncPool[T any]() *SyncPool[T] {
+	return &SyncPool[T]{
+		pool: sync.Pool{New: func() any { return &Node[T]{} }},
+	}
+}
+
+func (p *SyncPool[T]) Get() *Node[T] {
+	return p.pool.Get().(*Node[T])
+}
+
+func (p *SyncPool[T]) Put(n *Node[T]) {
+	var zero T
+	n.Data = zero
+	n.Next = nil
+	p.pool.Put(n)
+}
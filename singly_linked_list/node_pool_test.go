@@ -0,0 +1,148 @@
+package stack_list
+
+import (
+	"testing"
+
+	"github.com/jdavasligil/golang-dsa/abstract/queue"
+	"github.com/jdavasligil/golang-dsa/abstract/queue/queuetest"
+	"github.com/jdavasligil/golang-dsa/abstract/stack"
+	"github.com/jdavasligil/golang-dsa/abstract/stack/stacktest"
+	arraylist "github.com/jdavasligil/golang-dsa/array_list"
+)
+
+func TestFreeList(t *testing.T) {
+	free := NewFreeList[*int](2)
+	s := NewStackListWithAllocator[*int](free)
+
+	val := new(int)
+	for range 3 {
+		s.Push(val)
+	}
+	for range 3 {
+		s.Pop()
+	}
+
+	if free.Len() != 2 {
+		t.Errorf("Free list length %d != expected max 2", free.Len())
+	}
+	for n := free.head; n != nil; n = n.Next {
+		if n.Data != nil {
+			t.Error("Released node still references its data")
+		}
+	}
+
+	s.Push(val)
+	if free.Len() != 1 {
+		t.Errorf("Push did not reuse a node. Free list length %d", free.Len())
+	}
+
+	s.Append(val)
+	s.Append(val)
+	s.RemoveIf(func(p *int) bool { return true })
+	if free.Len() != 2 || s.Len() != 0 {
+		t.Errorf("RemoveIf did not release nodes. Free %d Len %d", free.Len(), s.Len())
+	}
+}
+
+func TestAllocatorConformance(t *testing.T) {
+	allocators := []struct {
+		name  string
+		alloc func() NodeAllocator[int]
+	}{
+		{name: "FreeList", alloc: func() NodeAllocator[int] { return NewFreeList[int](8) }},
+		{name: "SyncPool", alloc: func() NodeAllocator[int] { return NewSyncPool[int]() }},
+	}
+
+	for _, a := range allocators {
+		t.Run(a.name, func(t *testing.T) {
+			stacktest.RunStackTests(t, func(capacity int) stack.Stack[int] {
+				return NewStackListWithAllocator(a.alloc())
+			})
+			queuetest.RunQueueTests(t, func(capacity int) queue.Queue[int] {
+				return NewStackListWithAllocator(a.alloc())
+			})
+		})
+	}
+}
+
+func TestAllocatorMerge(t *testing.T) {
+	free := NewFreeList[rune](16)
+	a := NewStackListWithAllocator[rune](free)
+	b := NewStackListWithAllocator[rune](free)
+	for _, r := range "ACE" {
+		a.Append(r)
+	}
+	for _, r := range "BD" {
+		b.Append(r)
+	}
+
+	merged := Merge(a, b)
+	if free.Len() != 0 {
+		t.Errorf("Merge released %d nodes which are still in use", free.Len())
+	}
+	checkList(t, merged, "ABCDE")
+
+	merged.Clear()
+	if free.Len() != 5 {
+		t.Errorf("Clear released %d nodes != expected 5", free.Len())
+	}
+}
+
+// Churn pattern: fill to a working depth then drain, repeatedly.
+const churnDepth = 64
+
+func BenchmarkChurnStackList(b *testing.B) {
+	s := NewStackList[int]()
+	b.ReportAllocs()
+
+	for b.Loop() {
+		for i := range churnDepth {
+			s.Push(i)
+		}
+		for range churnDepth {
+			s.Pop()
+		}
+	}
+}
+
+func BenchmarkChurnStackListFreeList(b *testing.B) {
+	s := NewStackListWithAllocator[int](NewFreeList[int](churnDepth))
+	b.ReportAllocs()
+
+	for b.Loop() {
+		for i := range churnDepth {
+			s.Push(i)
+		}
+		for range churnDepth {
+			s.Pop()
+		}
+	}
+}
+
+func BenchmarkChurnStackListSyncPool(b *testing.B) {
+	s := NewStackListWithAllocator[int](NewSyncPool[int]())
+	b.ReportAllocs()
+
+	for b.Loop() {
+		for i := range churnDepth {
+			s.Push(i)
+		}
+		for range churnDepth {
+			s.Pop()
+		}
+	}
+}
+
+func BenchmarkChurnArrayList(b *testing.B) {
+	s := arraylist.NewArrayListWithCapacity[int](0)
+	b.ReportAllocs()
+
+	for b.Loop() {
+		for i := range churnDepth {
+			s.Push(i)
+		}
+		for range churnDepth {
+			s.Pop()
+		}
+	}
+}
@@ -1,18 +1,45 @@
 // StackList
-// FILO data structure using a linked list
+// Singly linked list usable as a FILO stack (Push/Pop/Top) or, with its tail
+// pointer, a FIFO queue (Enqueue/Dequeue/Peek)
 
 package stack_list
 
-import "fmt"
+import (
+	"cmp"
+	"iter"
 
-type node[T any] struct {
+	"github.com/jdavasligil/golang-dsa/abstract/container"
+	"github.com/jdavasligil/golang-dsa/abstract/queue"
+	"github.com/jdavasligil/golang-dsa/abstract/stack"
+)
+
+var (
+	_ stack.Stack[int]        = (*StackList[int])(nil)
+	_ queue.Queue[int]        = (*StackList[int])(nil)
+	_ container.Sized         = (*StackList[int])(nil)
+	_ container.Clearable     = (*StackList[int])(nil)
+	_ container.Iterable[int] = (*StackList[int])(nil)
+)
+
+// Node is a handle to an element of a StackList. Changing Next directly
+// bypasses the list's length and tail bookkeeping.
+type Node[T any] struct {
 	Data T
-	Next *node[T]
+	Next *Node[T]
 }
 
+var (
+	errPopEmpty     = &container.EmptyError{Container: "StackList", Op: "pop"}
+	errDequeueEmpty = &container.EmptyError{Container: "StackList", Op: "dequeue"}
+	errEmpty        = &container.EmptyError{Container: "StackList"}
+	errHandle       = &container.HandleError{Container: "StackList"}
+)
+
 type StackList[T any] struct {
-	Head *node[T]
-	size int
+	Head  *Node[T]
+	tail  *Node[T]
+	size  int
+	alloc NodeAllocator[T]
 }
 
 func NewStackList[T any]() *StackList[T] {
@@ -22,33 +49,66 @@ func NewStackList[T any]() *StackList[T] {
 	}
 }
 
-func (s *StackList[T]) Push(data T) {
-	s.Head = &node[T]{Data: data, Next: s.Head}
+// NewStackListWithAllocator reuses removed nodes through alloc to reduce
+// allocations under heavy churn.
+func NewStackListWithAllocator[T any](alloc NodeAllocator[T]) *StackList[T] {
+	return &StackList[T]{alloc: alloc}
+}
+
+func (s *StackList[T]) newNode(data T, next *Node[T]) *Node[T] {
+	if s.alloc == nil {
+		return &Node[T]{Data: data, Next: next}
+	}
+
+	n := s.alloc.Get()
+	n.Data = data
+	n.Next = next
+
+	return n
+}
+
+func (s *StackList[T]) release(n *Node[T]) {
+	if s.alloc != nil {
+		s.alloc.Put(n)
+	}
+}
+
+// Push never fails. The error is for conformance with stack.Stack.
+func (s *StackList[T]) Push(data T) error {
+	s.Head = s.newNode(data, s.Head)
+	if s.tail == nil {
+		s.tail = s.Head
+	}
 	s.size += 1
+
+	return nil
 }
 
 func (s *StackList[T]) Pop() (T, error) {
 	var result T
-	var err error = fmt.Errorf("Failed to pop from empty StackList.")
 
 	if s.Head == nil {
-		return result, err
+		return result, errPopEmpty
 	}
 
 	result = s.Head.Data
 
+	n := s.Head
 	s.Head = s.Head.Next
+	if s.Head == nil {
+		s.tail = nil
+	}
 	s.size -= 1
+	s.release(n)
 
 	return result, nil
 }
 
 func (s *StackList[T]) Top() (T, error) {
 	var result T
-	var err error = fmt.Errorf("StackList is empty.")
 
 	if s.Head == nil {
-		return result, err
+		return result, errEmpty
 	}
 
 	result = s.Head.Data
@@ -56,6 +116,237 @@ func (s *StackList[T]) Top() (T, error) {
 	return result, nil
 }
 
+// Append adds data after the last node in O(1).
+func (s *StackList[T]) Append(data T) {
+	n := s.newNode(data, nil)
+	if s.tail == nil {
+		s.Head = n
+	} else {
+		s.tail.Next = n
+	}
+	s.tail = n
+	s.size += 1
+}
+
+// Enqueue never fails. The error is for conformance with queue.Queue.
+func (s *StackList[T]) Enqueue(data T) error {
+	s.Append(data)
+	return nil
+}
+
+func (s *StackList[T]) Dequeue() (T, error) {
+	if s.Head == nil {
+		var result T
+		return result, errDequeueEmpty
+	}
+	return s.Pop()
+}
+
+func (s *StackList[T]) Peek() (T, error) {
+	return s.Top()
+}
+
+// Tail returns the last node, or nil if empty.
+func (s *StackList[T]) Tail() *Node[T] {
+	return s.tail
+}
+
 func (s *StackList[T]) Len() int {
 	return s.size
 }
+
+func (s *StackList[T]) IsEmpty() bool {
+	return s.Head == nil
+}
+
+func (s *StackList[T]) Clear() {
+	if s.alloc != nil {
+		for n := s.Head; n != nil; {
+			next := n.Next
+			s.alloc.Put(n)
+			n = next
+		}
+	}
+
+	s.Head = nil
+	s.tail = nil
+	s.size = 0
+}
+
+// All iterates from the top of the stack to the bottom.
+func (s *StackList[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for n := s.Head; n != nil; n = n.Next {
+			if !yield(n.Data) {
+				return
+			}
+		}
+	}
+}
+
+// Reverse relinks the nodes in place.
+func (s *StackList[T]) Reverse() {
+	var prev *Node[T]
+	s.tail = s.Head
+
+	for n := s.Head; n != nil; {
+		next := n.Next
+		n.Next = prev
+		prev = n
+		n = next
+	}
+
+	s.Head = prev
+}
+
+// Find returns the first node satisfying f, or nil.
+func (s *StackList[T]) Find(f func(T) bool) *Node[T] {
+	for n := s.Head; n != nil; n = n.Next {
+		if f(n.Data) {
+			return n
+		}
+	}
+	return nil
+}
+
+// RemoveIf unlinks every node satisfying f and returns how many were removed.
+func (s *StackList[T]) RemoveIf(f func(T) bool) int {
+	var removed int
+	var prev *Node[T]
+
+	for n := s.Head; n != nil; {
+		next := n.Next
+		if !f(n.Data) {
+			prev = n
+			n = next
+			continue
+		}
+		if prev == nil {
+			s.Head = next
+		} else {
+			prev.Next = next
+		}
+		s.release(n)
+		removed++
+		n = next
+	}
+
+	s.tail = prev
+	s.size -= removed
+
+	return removed
+}
+
+// InsertAfter links data after mark, which must be a node of s. Only a nil
+// mark is detected; checking membership would cost O(n).
+func (s *StackList[T]) InsertAfter(mark *Node[T], data T) (*Node[T], error) {
+	if mark == nil {
+		return nil, errHandle
+	}
+
+	n := s.newNode(data, mark.Next)
+	mark.Next = n
+	if s.tail == mark {
+		s.tail = n
+	}
+	s.size += 1
+
+	return n, nil
+}
+
+// RemoveAfter unlinks and returns the node after mark, which must be a node
+// of s.
+func (s *StackList[T]) RemoveAfter(mark *Node[T]) (T, error) {
+	var result T
+
+	if mark == nil || mark.Next == nil {
+		return result, errHandle
+	}
+
+	n := mark.Next
+	mark.Next = n.Next
+	if s.tail == n {
+		s.tail = mark
+	}
+	s.size -= 1
+	result = n.Data
+	s.release(n)
+
+	return result, nil
+}
+
+// MergeFunc relinks the nodes of two lists sorted by compare into a single
+// sorted list. The merge is stable and leaves a and b empty. The result uses
+// the allocator of a. If a and b are the same list its nodes are moved to the
+// result unchanged.
+func MergeFunc[T any](a, b *StackList[T], compare func(x, y T) int) *StackList[T] {
+	merged := NewStackListWithAllocator(a.alloc)
+	if a == b {
+		merged.Head, merged.tail, merged.size = a.Head, a.tail, a.size
+		a.Head, a.tail, a.size = nil, nil, 0
+		return merged
+	}
+
+	var dummy Node[T]
+	last := &dummy
+
+	x, y := a.Head, b.Head
+	for x != nil && y != nil {
+		if compare(y.Data, x.Data) < 0 {
+			last.Next = y
+			y = y.Next
+		} else {
+			last.Next = x
+			x = x.Next
+		}
+		last = last.Next
+	}
+
+	if x != nil {
+		last.Next = x
+		merged.tail = a.tail
+	} else if y != nil {
+		last.Next = y
+		merged.tail = b.tail
+	}
+
+	merged.Head = dummy.Next
+	merged.size = a.size + b.size
+	a.Head, a.tail, a.size = nil, nil, 0
+	b.Head, b.tail, b.size = nil, nil, 0
+
+	return merged
+}
+
+// Merge relinks two ascending lists into a single ascending list.
+func Merge[T cmp.Ordered](a, b *StackList[T]) *StackList[T] {
+	return MergeFunc(a, b, cmp.Compare[T])
+}
+
+// HasCycle reports whether following Next from head loops forever, using
+// Floyd's tortoise and hare.
+func HasCycle[T any](head *Node[T]) bool {
+	return CycleStart(head) != nil
+}
+
+// CycleStart returns the first node of the cycle reachable from head, or nil
+// if the chain terminates.
+func CycleStart[T any](head *Node[T]) *Node[T] {
+	slow, fast := head, head
+
+	for fast != nil && fast.Next != nil {
+		slow = slow.Next
+		fast = fast.Next.Next
+		if slow == fast {
+			// The distance from head to the cycle start equals the
+			// distance from the meeting point to it, modulo the cycle.
+			for slow = head; slow != fast; {
+				slow = slow.Next
+				fast = fast.Next
+			}
+			return slow
+		}
+	}
+
+	return nil
+}
@@ -1,6 +1,16 @@
 package stack_list
 
-import "testing"
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/jdavasligil/golang-dsa/abstract/container"
+	"github.com/jdavasligil/golang-dsa/abstract/queue"
+	"github.com/jdavasligil/golang-dsa/abstract/queue/queuetest"
+	"github.com/jdavasligil/golang-dsa/abstract/stack"
+	"github.com/jdavasligil/golang-dsa/abstract/stack/stacktest"
+)
 
 func TestNewStackList(t *testing.T) {
 	stack := NewStackList[rune]()
@@ -116,3 +126,244 @@ func TestStackListLen(t *testing.T) {
 		t.Errorf("Length %d != expected %d", got, want)
 	}
 }
+
+func TestStackListErrors(t *testing.T) {
+	stack := NewStackList[rune]()
+
+	_, err := stack.Pop()
+	if !errors.Is(err, &container.EmptyError{}) {
+		t.Errorf("Got: %v  Expected: container.EmptyError.", err)
+	}
+	if err.Error() != "Failed to pop from empty StackList." {
+		t.Errorf("Message %q changed", err.Error())
+	}
+
+	_, err = stack.Top()
+	var emptyErr *container.EmptyError
+	if !errors.As(err, &emptyErr) || emptyErr.Container != "StackList" {
+		t.Errorf("Got: %v  Expected: container.EmptyError.", err)
+	}
+	if err.Error() != "StackList is empty." {
+		t.Errorf("Message %q changed", err.Error())
+	}
+}
+
+func TestStackListStackConformance(t *testing.T) {
+	stacktest.RunStackTests(t, func(capacity int) stack.Stack[int] { return NewStackList[int]() })
+}
+
+func TestStackListCapabilities(t *testing.T) {
+	stack := NewStackList[rune]()
+
+	if !stack.IsEmpty() {
+		t.Error("New StackList should be empty")
+	}
+
+	for _, r := range "ABC" {
+		stack.Push(r)
+	}
+
+	if got := string(slices.Collect(stack.All())); got != "CBA" {
+		t.Errorf("All = %s != expected CBA", got)
+	}
+
+	stack.Clear()
+	if !stack.IsEmpty() || stack.Len() != 0 {
+		t.Errorf("Len %d after Clear != expected 0", stack.Len())
+	}
+}
+
+func listOf(values string) *StackList[rune] {
+	s := NewStackList[rune]()
+	for _, r := range values {
+		s.Append(r)
+	}
+	return s
+}
+
+// Checks contents, length and that the tail pointer is the last node.
+func checkList(t *testing.T, s *StackList[rune], expected string) {
+	t.Helper()
+
+	if got := string(slices.Collect(s.All())); got != expected {
+		t.Errorf("List = %s != expected %s", got, expected)
+	}
+	if s.Len() != len(expected) {
+		t.Errorf("Length %d != expected %d", s.Len(), len(expected))
+	}
+
+	var last *Node[rune]
+	for n := s.Head; n != nil; n = n.Next {
+		last = n
+	}
+	if s.Tail() != last {
+		t.Error("Tail does not point at the last node")
+	}
+}
+
+func TestStackListAppend(t *testing.T) {
+	s := NewStackList[rune]()
+
+	s.Append('B')
+	s.Push('A')
+	s.Append('C')
+	checkList(t, s, "ABC")
+
+	for range 3 {
+		s.Pop()
+	}
+	checkList(t, s, "")
+
+	s.Append('D')
+	checkList(t, s, "D")
+
+	if _, err := NewStackList[rune]().Dequeue(); err == nil || err.Error() != "Failed to dequeue from empty StackList." {
+		t.Errorf("Dequeue on empty Got: %v", err)
+	}
+}
+
+func TestStackListReverse(t *testing.T) {
+	tests := []struct {
+		values   string
+		expected string
+	}{
+		{values: "", expected: ""},
+		{values: "A", expected: "A"},
+		{values: "ABCD", expected: "DCBA"},
+	}
+
+	for _, test := range tests {
+		s := listOf(test.values)
+		s.Reverse()
+		checkList(t, s, test.expected)
+		s.Append('Z')
+		checkList(t, s, test.expected+"Z")
+	}
+}
+
+func TestStackListFindInsertRemove(t *testing.T) {
+	s := listOf("ABCD")
+
+	c := s.Find(func(r rune) bool { return r == 'C' })
+	if c == nil || c.Data != 'C' {
+		t.Fatalf("Find returned %v", c)
+	}
+	if s.Find(func(r rune) bool { return r == 'Z' }) != nil {
+		t.Error("Find should return nil when nothing matches")
+	}
+
+	if _, err := s.InsertAfter(c, 'c'); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.InsertAfter(s.Tail(), 'E'); err != nil {
+		t.Fatal(err)
+	}
+	checkList(t, s, "ABCcDE")
+
+	if _, err := s.InsertAfter(nil, 'X'); !errors.Is(err, &container.HandleError{}) {
+		t.Errorf("Got: %v  Expected: container.HandleError.", err)
+	}
+
+	val, err := s.RemoveAfter(s.Find(func(r rune) bool { return r == 'D' }))
+	if err != nil || val != 'E' {
+		t.Errorf("RemoveAfter = %c (%v) != expected E", val, err)
+	}
+	checkList(t, s, "ABCcD")
+
+	if _, err := s.RemoveAfter(s.Tail()); !errors.Is(err, &container.HandleError{}) {
+		t.Errorf("Got: %v  Expected: container.HandleError.", err)
+	}
+}
+
+func TestStackListRemoveIf(t *testing.T) {
+	tests := []struct {
+		values   string
+		removed  int
+		expected string
+	}{
+		{values: "", removed: 0, expected: ""},
+		{values: "aBcDe", removed: 3, expected: "BD"},
+		{values: "abc", removed: 3, expected: ""},
+		{values: "ABc", removed: 1, expected: "AB"},
+		{values: "ABC", removed: 0, expected: "ABC"},
+	}
+
+	for _, test := range tests {
+		s := listOf(test.values)
+		removed := s.RemoveIf(func(r rune) bool { return r >= 'a' })
+		if removed != test.removed {
+			t.Errorf("%s: removed %d != expected %d", test.values, removed, test.removed)
+		}
+		checkList(t, s, test.expected)
+	}
+}
+
+func TestStackListMerge(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		expected string
+	}{
+		{a: "", b: "", expected: ""},
+		{a: "ACE", b: "", expected: "ACE"},
+		{a: "", b: "BD", expected: "BD"},
+		{a: "ACE", b: "BDFGH", expected: "ABCDEFGH"},
+		{a: "DEF", b: "ABC", expected: "ABCDEF"},
+	}
+
+	for _, test := range tests {
+		a := listOf(test.a)
+		b := listOf(test.b)
+		merged := Merge(a, b)
+
+		checkList(t, merged, test.expected)
+		checkList(t, a, "")
+		checkList(t, b, "")
+	}
+
+	type pair struct {
+		key rune
+		src int
+	}
+	a := NewStackList[pair]()
+	b := NewStackList[pair]()
+	a.Append(pair{'A', 0})
+	b.Append(pair{'A', 1})
+	merged := MergeFunc(a, b, func(x, y pair) int { return int(x.key - y.key) })
+	if first, _ := merged.Pop(); first.src != 0 {
+		t.Error("MergeFunc is not stable")
+	}
+
+	same := listOf("ACE")
+	checkList(t, Merge(same, same), "ACE")
+	checkList(t, same, "")
+}
+
+func TestStackListCycles(t *testing.T) {
+	s := listOf("ABCDE")
+
+	if HasCycle(s.Head) || CycleStart(s.Head) != nil {
+		t.Error("Acyclic list reported a cycle")
+	}
+	if HasCycle[rune](nil) {
+		t.Error("Empty chain reported a cycle")
+	}
+
+	c := s.Find(func(r rune) bool { return r == 'C' })
+	s.Tail().Next = c
+	if !HasCycle(s.Head) {
+		t.Error("Cycle not detected")
+	}
+	if start := CycleStart(s.Head); start != c {
+		t.Errorf("CycleStart = %v != expected node C", start)
+	}
+
+	self := &Node[rune]{Data: 'X'}
+	self.Next = self
+	if CycleStart(self) != self {
+		t.Error("Self loop not detected")
+	}
+}
+
+func TestStackListQueueConformance(t *testing.T) {
+	queuetest.RunQueueTests(t, func(capacity int) queue.Queue[int] { return NewStackList[int]() })
+}
@@ -0,0 +1,89 @@
+package stack_list
+
+import (
+	"sync/atomic"
+
+	"github.com/jdavasligil/golang-dsa/abstract/container"
+	"github.com/jdavasligil/golang-dsa/abstract/stack"
+)
+
+var _ stack.Stack[int] = (*TreiberStack[int])(nil)
+
+var (
+	errTreiberPopEmpty = &container.EmptyError{Container: "TreiberStack", Op: "pop"}
+	errTreiberEmpty    = &container.EmptyError{Container: "TreiberStack"}
+)
+
+// Nodes are immutable once published to head.
+type treiberNode[T any] struct {
+	data T
+	next *treiberNode[T]
+}
+
+// TreiberStack is a lock-free LIFO stack which any number of goroutines may
+// Push and Pop concurrently. The zero value is an empty stack ready to use.
+//
+// ABA: a classic Treiber stack can corrupt itself when a node is popped,
+// freed and reused at the same address between another goroutine's Load and
+// CompareAndSwap of head. Here every Push allocates a fresh node and popped
+// nodes are never recycled, so the garbage collector keeps a node's address
+// from being reused while any goroutine still holds it. Do not add node
+// pooling (see NodeAllocator) to this type without a tagged or hazard pointer
+// scheme.
+type TreiberStack[T any] struct {
+	head atomic.Pointer[treiberNode[T]]
+	size atomic.Int64
+}
+
+func NewTreiberStack[T any]() *TreiberStack[T] {
+	return &TreiberStack[T]{}
+}
+
+// Push never fails. The error is for conformance with stack.Stack.
+func (s *TreiberStack[T]) Push(data T) error {
+	n := &treiberNode[T]{data: data}
+
+	for {
+		n.next = s.head.Load()
+		if s.head.CompareAndSwap(n.next, n) {
+			s.size.Add(1)
+			return nil
+		}
+	}
+}
+
+func (s *TreiberStack[T]) Pop() (T, error) {
+	for {
+		old := s.head.Load()
+		if old == nil {
+			var result T
+			return result, errTreiberPopEmpty
+		}
+		if s.head.CompareAndSwap(old, old.next) {
+			s.size.Add(-1)
+			return old.data, nil
+		}
+	}
+}
+
+func (s *TreiberStack[T]) Top() (T, error) {
+	var result T
+
+	n := s.head.Load()
+	if n == nil {
+		return result, errTreiberEmpty
+	}
+
+	return n.data, nil
+}
+
+// Len is approximate while other goroutines modify the stack. The count is
+// updated after head, so a Pop can be counted before the Push it removed and
+// the raw count briefly dip below zero; Len clamps it to zero.
+func (s *TreiberStack[T]) Len() int {
+	return max(0, int(s.size.Load()))
+}
+
+func (s *TreiberStack[T]) IsEmpty() bool {
+	return s.head.Load() == nil
+}
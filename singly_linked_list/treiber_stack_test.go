@@ -0,0 +1,151 @@
+package stack_list
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/jdavasligil/golang-dsa/abstract/stack"
+	"github.com/jdavasligil/golang-dsa/abstract/stack/stacktest"
+)
+
+func TestTreiberStackConformance(t *testing.T) {
+	stacktest.RunStackTests(t, func(capacity int) stack.Stack[int] { return NewTreiberStack[int]() })
+}
+
+func TestTreiberStackConcurrent(t *testing.T) {
+	const goroutines = 8
+	const perGoroutine = 2000
+
+	s := NewTreiberStack[int]()
+	var wg sync.WaitGroup
+
+	for g := range goroutines {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range perGoroutine {
+				s.Push(g*perGoroutine + i)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if s.Len() != goroutines*perGoroutine {
+		t.Fatalf("Length %d != expected %d", s.Len(), goroutines*perGoroutine)
+	}
+
+	popped := make([][]int, goroutines)
+	for g := range goroutines {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				val, err := s.Pop()
+				if err != nil {
+					return
+				}
+				popped[g] = append(popped[g], val)
+			}
+		}()
+	}
+	wg.Wait()
+
+	seen := make([]bool, goroutines*perGoroutine)
+	for _, vals := range popped {
+		for _, v := range vals {
+			if seen[v] {
+				t.Fatalf("Value %d popped twice", v)
+			}
+			seen[v] = true
+		}
+	}
+	for v, ok := range seen {
+		if !ok {
+			t.Fatalf("Value %d never popped", v)
+		}
+	}
+	if !s.IsEmpty() || s.Len() != 0 {
+		t.Errorf("Stack not empty after draining. Len %d", s.Len())
+	}
+}
+
+func TestTreiberStackMixed(t *testing.T) {
+	s := NewTreiberStack[int]()
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	var pushes, pops int
+
+	for range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var pushed, popped int
+			for i := range 5000 {
+				if i%3 == 2 {
+					if _, err := s.Pop(); err == nil {
+						popped++
+					}
+				} else {
+					s.Push(i)
+					pushed++
+				}
+			}
+			mu.Lock()
+			pushes += pushed
+			pops += popped
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+
+	if s.Len() != pushes-pops {
+		t.Errorf("Length %d != pushes %d - pops %d", s.Len(), pushes, pops)
+	}
+}
+
+// StackList guarded by a mutex, the baseline the Treiber stack replaces.
+type mutexStack struct {
+	mu sync.Mutex
+	s  *StackList[int]
+}
+
+func (m *mutexStack) Push(v int) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.s.Push(v)
+}
+
+func (m *mutexStack) Pop() (int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.s.Pop()
+}
+
+func (m *mutexStack) Top() (int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.s.Top()
+}
+
+func benchmarkParallelStack(b *testing.B, s stack.Stack[int]) {
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		var i int
+		for pb.Next() {
+			if i%2 == 0 {
+				s.Push(i)
+			} else {
+				s.Pop()
+			}
+			i++
+		}
+	})
+}
+
+func BenchmarkParallelTreiberStack(b *testing.B) {
+	benchmarkParallelStack(b, NewTreiberStack[int]())
+}
+
+func BenchmarkParallelMutexStackList(b *testing.B) {
+	benchmarkParallelStack(b, &mutexStack{s: NewStackList[int]()})
+}
@@ -0,0 +1,228 @@
+package trie
+
+import (
+	"iter"
+	"slices"
+	"strings"
+)
+
+var _ PrefixMap[int] = (*RadixTree[int])(nil)
+
+type radixNode[V any] struct {
+	// Label of the edge from the parent. Only the root has an empty label.
+	prefix   string
+	value    V
+	hasValue bool
+
+	// Sorted by the first byte of their prefix, which is unique.
+	children []*radixNode[V]
+}
+
+func (n *radixNode[V]) child(b byte) (int, bool) {
+	return slices.BinarySearchFunc(n.children, b, func(c *radixNode[V], b byte) int {
+		return int(c.prefix[0]) - int(b)
+	})
+}
+
+// Absorb the only child of a node without a value.
+func (n *radixNode[V]) mergeChild() {
+	c := n.children[0]
+	n.prefix += c.prefix
+	n.value = c.value
+	n.hasValue = c.hasValue
+	n.children = c.children
+}
+
+func commonPrefix(a, b string) int {
+	i := 0
+	for i < len(a) && i < len(b) && a[i] == b[i] {
+		i++
+	}
+	return i
+}
+
+// RadixTree is a trie where chains of nodes with a single child are merged
+// into one edge, so it uses a node per key rather than per byte.
+//
+// The zero value is an empty tree ready to use.
+type RadixTree[V any] struct {
+	root radixNode[V]
+	size int
+}
+
+func NewRadixTree[V any]() *RadixTree[V] {
+	return &RadixTree[V]{}
+}
+
+func (t *RadixTree[V]) Insert(key string, value V) {
+	n := &t.root
+	for key != "" {
+		i, ok := n.child(key[0])
+		if !ok {
+			n.children = slices.Insert(n.children, i, &radixNode[V]{prefix: key})
+			n = n.children[i]
+			break
+		}
+
+		c := n.children[i]
+		common := commonPrefix(c.prefix, key)
+		if common < len(c.prefix) {
+			// Split the edge where the key diverges.
+			mid := &radixNode[V]{prefix: c.prefix[:common], children: []*radixNode[V]{c}}
+			c.prefix = c.prefix[common:]
+			n.children[i] = mid
+			c = mid
+		}
+		n = c
+		key = key[common:]
+	}
+
+	if !n.hasValue {
+		t.size++
+	}
+	n.value = value
+	n.hasValue = true
+}
+
+func (t *RadixTree[V]) find(key string) *radixNode[V] {
+	n := &t.root
+	for key != "" {
+		i, ok := n.child(key[0])
+		if !ok || !strings.HasPrefix(key, n.children[i].prefix) {
+			return nil
+		}
+		n = n.children[i]
+		key = key[len(n.prefix):]
+	}
+	return n
+}
+
+func (t *RadixTree[V]) Get(key string) (V, bool) {
+	if n := t.find(key); n != nil && n.hasValue {
+		return n.value, true
+	}
+
+	var result V
+	return result, false
+}
+
+// Delete removes key, merging edges left with a single child.
+func (t *RadixTree[V]) Delete(key string) bool {
+	var parent *radixNode[V]
+	n := &t.root
+	for key != "" {
+		i, ok := n.child(key[0])
+		if !ok || !strings.HasPrefix(key, n.children[i].prefix) {
+			return false
+		}
+		parent, n = n, n.children[i]
+		key = key[len(n.prefix):]
+	}
+	if !n.hasValue {
+		return false
+	}
+
+	n.value = *new(V)
+	n.hasValue = false
+	t.size--
+
+	if n == &t.root {
+		return true
+	}
+
+	switch len(n.children) {
+	case 0:
+		i, _ := parent.child(n.prefix[0])
+		parent.children = slices.Delete(parent.children, i, i+1)
+		if parent != &t.root && !parent.hasValue && len(parent.children) == 1 {
+			parent.mergeChild()
+		}
+	case 1:
+		n.mergeChild()
+	}
+
+	return true
+}
+
+func (t *RadixTree[V]) LongestPrefix(s string) (string, V, bool) {
+	var value V
+	length := -1
+
+	n := &t.root
+	for consumed := 0; ; {
+		if n.hasValue {
+			value, length = n.value, consumed
+		}
+		if consumed == len(s) {
+			break
+		}
+		i, ok := n.child(s[consumed])
+		if !ok || !strings.HasPrefix(s[consumed:], n.children[i].prefix) {
+			break
+		}
+		n = n.children[i]
+		consumed += len(n.prefix)
+	}
+
+	if length < 0 {
+		return "", value, false
+	}
+	return s[:length], value, true
+}
+
+func (t *RadixTree[V]) walk(n *radixNode[V], key []byte, yield func(string, V) bool) bool {
+	if n.hasValue && !yield(string(key), n.value) {
+		return false
+	}
+	for _, c := range n.children {
+		if !t.walk(c, append(key, c.prefix...), yield) {
+			return false
+		}
+	}
+	return true
+}
+
+func (t *RadixTree[V]) WalkPrefix(prefix string) iter.Seq2[string, V] {
+	return func(yield func(string, V) bool) {
+		n := &t.root
+		key := []byte{}
+		for rest := prefix; rest != ""; {
+			i, ok := n.child(rest[0])
+			if !ok {
+				return
+			}
+			n = n.children[i]
+
+			// The prefix may end part way along an edge.
+			common := commonPrefix(n.prefix, rest)
+			if common < len(n.prefix) && common < len(rest) {
+				return
+			}
+			key = append(key, n.prefix...)
+			rest = rest[common:]
+		}
+		t.walk(n, key, yield)
+	}
+}
+
+// All iterates every key in order.
+func (t *RadixTree[V]) All() iter.Seq2[string, V] {
+	return t.WalkPrefix("")
+}
+
+func (t *RadixTree[V]) Autocomplete(prefix string, k int, compare func(a, b V) int) []string {
+	return autocomplete(t.WalkPrefix(prefix), k, compare)
+}
+
+func (t *RadixTree[V]) Len() int {
+	return t.size
+}
+
+func (t *RadixTree[V]) IsEmpty() bool {
+	return t.size == 0
+}
+
+func (t *RadixTree[V]) Clear() {
+	t.root = radixNode[V]{}
+	t.size = 0
+}
@@ -0,0 +1,229 @@
+// Trie
+// Maps from string keys supporting prefix queries
+
+package trie
+
+import (
+	"iter"
+	"slices"
+
+	"github.com/jdavasligil/golang-dsa/abstract/container"
+	pq "github.com/jdavasligil/golang-dsa/priority_queue"
+)
+
+// PrefixMap is implemented by Trie and RadixTree. Keys are compared and
+// iterated byte by byte, so iteration order is lexicographic by byte.
+type PrefixMap[V any] interface {
+	container.Sized
+	container.Clearable
+
+	// Insert adds key or replaces its value.
+	Insert(key string, value V)
+	Get(key string) (V, bool)
+	Delete(key string) bool
+
+	// LongestPrefix returns the longest key which is a prefix of s.
+	LongestPrefix(s string) (string, V, bool)
+
+	// WalkPrefix iterates every key starting with prefix in order.
+	WalkPrefix(prefix string) iter.Seq2[string, V]
+
+	// Autocomplete returns up to k keys starting with prefix with the
+	// greatest values according to compare, greatest first. Equal values
+	// are ordered by key.
+	Autocomplete(prefix string, k int, compare func(a, b V) int) []string
+}
+
+var _ PrefixMap[int] = (*Trie[int])(nil)
+
+type entry[V any] struct {
+	key   string
+	value V
+}
+
+func autocomplete[V any](entries iter.Seq2[string, V], k int, compare func(a, b V) int) []string {
+	if k <= 0 {
+		return nil
+	}
+
+	// Rank by value, then prefer the earlier key.
+	less := func(a, b entry[V]) bool {
+		if c := compare(a.value, b.value); c != 0 {
+			return c < 0
+		}
+		return a.key > b.key
+	}
+	best, _ := pq.NewBoundedMinMaxHeap(k, less)
+	for key, value := range entries {
+		best.PushBackOver(entry[V]{key, value})
+	}
+
+	keys := make([]string, 0, best.Len())
+	for !best.IsEmpty() {
+		e, _ := best.PopBack()
+		keys = append(keys, e.key)
+	}
+	return keys
+}
+
+type trieNode[V any] struct {
+	value    V
+	hasValue bool
+
+	// Sorted edge bytes, parallel to children.
+	labels   []byte
+	children []*trieNode[V]
+}
+
+func (n *trieNode[V]) child(b byte) (int, bool) {
+	return slices.BinarySearch(n.labels, b)
+}
+
+// Trie stores one node per key byte.
+//
+// The zero value is an empty trie ready to use.
+type Trie[V any] struct {
+	root trieNode[V]
+	size int
+}
+
+func NewTrie[V any]() *Trie[V] {
+	return &Trie[V]{}
+}
+
+func (t *Trie[V]) find(key string) *trieNode[V] {
+	n := &t.root
+	for i := range len(key) {
+		j, ok := n.child(key[i])
+		if !ok {
+			return nil
+		}
+		n = n.children[j]
+	}
+	return n
+}
+
+func (t *Trie[V]) Insert(key string, value V) {
+	n := &t.root
+	for i := range len(key) {
+		j, ok := n.child(key[i])
+		if !ok {
+			n.labels = slices.Insert(n.labels, j, key[i])
+			n.children = slices.Insert(n.children, j, &trieNode[V]{})
+		}
+		n = n.children[j]
+	}
+
+	if !n.hasValue {
+		t.size++
+	}
+	n.value = value
+	n.hasValue = true
+}
+
+func (t *Trie[V]) Get(key string) (V, bool) {
+	if n := t.find(key); n != nil && n.hasValue {
+		return n.value, true
+	}
+
+	var result V
+	return result, false
+}
+
+// Delete removes key and prunes the nodes only it was using.
+func (t *Trie[V]) Delete(key string) bool {
+	path := make([]*trieNode[V], 0, len(key)+1)
+	n := &t.root
+	path = append(path, n)
+	for i := range len(key) {
+		j, ok := n.child(key[i])
+		if !ok {
+			return false
+		}
+		n = n.children[j]
+		path = append(path, n)
+	}
+	if !n.hasValue {
+		return false
+	}
+
+	n.value = *new(V)
+	n.hasValue = false
+	t.size--
+
+	for i := len(key); i > 0 && !path[i].hasValue && len(path[i].children) == 0; i-- {
+		parent := path[i-1]
+		j, _ := parent.child(key[i-1])
+		parent.labels = slices.Delete(parent.labels, j, j+1)
+		parent.children = slices.Delete(parent.children, j, j+1)
+	}
+
+	return true
+}
+
+func (t *Trie[V]) LongestPrefix(s string) (string, V, bool) {
+	var value V
+	length := -1
+
+	n := &t.root
+	for i := 0; ; i++ {
+		if n.hasValue {
+			value, length = n.value, i
+		}
+		if i == len(s) {
+			break
+		}
+		j, ok := n.child(s[i])
+		if !ok {
+			break
+		}
+		n = n.children[j]
+	}
+
+	if length < 0 {
+		return "", value, false
+	}
+	return s[:length], value, true
+}
+
+func (t *Trie[V]) walk(n *trieNode[V], key []byte, yield func(string, V) bool) bool {
+	if n.hasValue && !yield(string(key), n.value) {
+		return false
+	}
+	for i, c := range n.children {
+		if !t.walk(c, append(key, n.labels[i]), yield) {
+			return false
+		}
+	}
+	return true
+}
+
+func (t *Trie[V]) WalkPrefix(prefix string) iter.Seq2[string, V] {
+	return func(yield func(string, V) bool) {
+		if n := t.find(prefix); n != nil {
+			t.walk(n, []byte(prefix), yield)
+		}
+	}
+}
+
+// All iterates every key in order.
+func (t *Trie[V]) All() iter.Seq2[string, V] {
+	return t.WalkPrefix("")
+}
+
+func (t *Trie[V]) Autocomplete(prefix string, k int, compare func(a, b V) int) []string {
+	return autocomplete(t.WalkPrefix(prefix), k, compare)
+}
+
+func (t *Trie[V]) Len() int {
+	return t.size
+}
+
+func (t *Trie[V]) IsEmpty() bool {
+	return t.size == 0
+}
+
+func (t *Trie[V]) Clear() {
+	t.root = trieNode[V]{}
+	t.size = 0
+}
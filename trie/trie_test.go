@@ -0,0 +1,235 @@
+package trie
+
+import (
+	"cmp"
+	"maps"
+	"math/rand/v2"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// Every non-root node must hold a value or have children to lead to one.
+func checkTrie[V any](t *testing.T, tr *Trie[V]) {
+	t.Helper()
+
+	count := 0
+	var check func(n *trieNode[V])
+	check = func(n *trieNode[V]) {
+		if n.hasValue {
+			count++
+		} else if n != &tr.root && len(n.children) == 0 {
+			t.Fatal("Leaf without a value")
+		}
+		if !slices.IsSorted(n.labels) || len(n.labels) != len(n.children) {
+			t.Fatalf("Labels %q out of order or mismatched", n.labels)
+		}
+		for _, c := range n.children {
+			check(c)
+		}
+	}
+	check(&tr.root)
+
+	if count != tr.size {
+		t.Fatalf("Value count %d != Len %d", count, tr.size)
+	}
+}
+
+// Every non-root node has a label, and a node without a value branches, so no
+// chain could have been merged.
+func checkRadixTree[V any](t *testing.T, tr *RadixTree[V]) {
+	t.Helper()
+
+	count := 0
+	var check func(n *radixNode[V])
+	check = func(n *radixNode[V]) {
+		if n != &tr.root {
+			if n.prefix == "" {
+				t.Fatal("Non-root node with an empty label")
+			}
+			if !n.hasValue && len(n.children) < 2 {
+				t.Fatalf("Node %q without a value has %d children", n.prefix, len(n.children))
+			}
+		}
+		if n.hasValue {
+			count++
+		}
+		for i, c := range n.children {
+			if i > 0 && n.children[i-1].prefix[0] >= c.prefix[0] {
+				t.Fatalf("Children %q and %q out of order", n.children[i-1].prefix, c.prefix)
+			}
+			check(c)
+		}
+	}
+	check(&tr.root)
+
+	if count != tr.size {
+		t.Fatalf("Value count %d != Len %d", count, tr.size)
+	}
+}
+
+func testPrefixMap(t *testing.T, m PrefixMap[int], check func(t *testing.T)) {
+	t.Run("Emotes", func(t *testing.T) {
+		counts := map[string]int{
+			"":          1,
+			":)":        40,
+			":(":        5,
+			":D":        40,
+			"!help":     3,
+			"!hello":    12,
+			"!hell":     7,
+			"!helpdesk": 1,
+		}
+		for k, v := range counts {
+			m.Insert(k, v)
+		}
+		m.Insert(":D", 50)
+		counts[":D"] = 50
+		check(t)
+
+		if m.Len() != len(counts) {
+			t.Errorf("Len %d != expected %d", m.Len(), len(counts))
+		}
+		if v, ok := m.Get(":D"); !ok || v != 50 {
+			t.Errorf("Get(:D) = %d (%t) != expected 50", v, ok)
+		}
+		if _, ok := m.Get("!hel"); ok {
+			t.Error("Get found a key which is only a prefix")
+		}
+
+		tests := []struct {
+			s        string
+			expected string
+		}{
+			{s: "!helpdesk please", expected: "!helpdesk"},
+			{s: "!helping", expected: "!help"},
+			{s: "!hello world", expected: "!hello"},
+			{s: "!hel", expected: ""},
+			{s: ":)!!", expected: ":)"},
+		}
+		for _, tt := range tests {
+			if key, v, ok := m.LongestPrefix(tt.s); !ok || key != tt.expected || v != counts[key] {
+				t.Errorf("LongestPrefix(%q) = %q, %d (%t) != expected %q", tt.s, key, v, ok, tt.expected)
+			}
+		}
+
+		var keys []string
+		for k, v := range m.WalkPrefix("!hel") {
+			if v != counts[k] {
+				t.Errorf("WalkPrefix yielded %q -> %d != expected %d", k, v, counts[k])
+			}
+			keys = append(keys, k)
+		}
+		if expected := []string{"!hell", "!hello", "!help", "!helpdesk"}; !slices.Equal(keys, expected) {
+			t.Errorf("WalkPrefix(!hel) = %q != expected %q", keys, expected)
+		}
+		if got := maps.Collect(m.WalkPrefix("")); !maps.Equal(got, counts) {
+			t.Errorf("WalkPrefix(\"\") = %v != expected %v", got, counts)
+		}
+		for range m.WalkPrefix("!x") {
+			t.Error("WalkPrefix yielded a key for an unused prefix")
+		}
+
+		if got := m.Autocomplete(":", 2, cmp.Compare[int]); !slices.Equal(got, []string{":D", ":)"}) {
+			t.Errorf("Autocomplete(:, 2) = %q != expected [:D :)]", got)
+		}
+		if got := m.Autocomplete("!he", 10, cmp.Compare[int]); !slices.Equal(got, []string{"!hello", "!hell", "!help", "!helpdesk"}) {
+			t.Errorf("Autocomplete(!he, 10) = %q", got)
+		}
+
+		for _, k := range []string{"!help", "", ":("} {
+			if !m.Delete(k) {
+				t.Errorf("Delete(%q) reported missing", k)
+			}
+		}
+		if m.Delete("!help") || m.Delete("!he") {
+			t.Error("Delete reported a missing key")
+		}
+		check(t)
+		if _, _, ok := m.LongestPrefix("!helping"); ok {
+			t.Error("LongestPrefix found a deleted key")
+		}
+
+		m.Clear()
+		if !m.IsEmpty() {
+			t.Error("Map not empty after Clear")
+		}
+	})
+
+	t.Run("Model", func(t *testing.T) {
+		rng := rand.New(rand.NewPCG(1, 2))
+		model := map[string]int{}
+		randomKey := func() string {
+			var b strings.Builder
+			for range rng.IntN(6) {
+				b.WriteByte("abc"[rng.IntN(3)])
+			}
+			return b.String()
+		}
+
+		for step := range 5000 {
+			key := randomKey()
+			switch rng.IntN(3) {
+			case 0, 1:
+				m.Insert(key, step)
+				model[key] = step
+			default:
+				_, ok := model[key]
+				if m.Delete(key) != ok {
+					t.Fatalf("Step %d: Delete(%q) != %t", step, key, ok)
+				}
+				delete(model, key)
+			}
+			if step%100 == 0 {
+				check(t)
+			}
+		}
+		check(t)
+
+		for range 200 {
+			prefix := randomKey()
+
+			var expected []string
+			for _, k := range slices.Sorted(maps.Keys(model)) {
+				if strings.HasPrefix(k, prefix) {
+					expected = append(expected, k)
+				}
+			}
+			var got []string
+			for k, v := range m.WalkPrefix(prefix) {
+				if v != model[k] {
+					t.Fatalf("WalkPrefix(%q) yielded %q -> %d != expected %d", prefix, k, v, model[k])
+				}
+				got = append(got, k)
+			}
+			if !slices.Equal(got, expected) {
+				t.Fatalf("WalkPrefix(%q) = %q != expected %q", prefix, got, expected)
+			}
+
+			longest, found := "", false
+			for k := range model {
+				if strings.HasPrefix(prefix, k) && len(k) >= len(longest) {
+					longest, found = k, true
+				}
+			}
+			if key, _, ok := m.LongestPrefix(prefix); ok != found || key != longest {
+				t.Fatalf("LongestPrefix(%q) = %q (%t) != expected %q (%t)", prefix, key, ok, longest, found)
+			}
+
+			slices.SortStableFunc(expected, func(a, b string) int { return cmp.Compare(model[b], model[a]) })
+			if got := m.Autocomplete(prefix, 3, cmp.Compare[int]); !slices.Equal(got, expected[:min(3, len(expected))]) {
+				t.Fatalf("Autocomplete(%q, 3) = %q != expected %q", prefix, got, expected[:min(3, len(expected))])
+			}
+		}
+	})
+}
+
+func TestTrie(t *testing.T) {
+	tr := NewTrie[int]()
+	testPrefixMap(t, tr, func(t *testing.T) { checkTrie(t, tr) })
+}
+
+func TestRadixTree(t *testing.T) {
+	tr := NewRadixTree[int]()
+	testPrefixMap(t, tr, func(t *testing.T) { checkRadixTree(t, tr) })
+}